@@ -94,7 +94,7 @@ resource "peekaping_monitor" "api_health" {
   resend_interval  = 10
   active           = true
   notification_ids = [peekaping_notification.email_alerts.id]
-  tag_ids          = [peekaping_tag.production.id]
+  tags             = [{ tag_id = peekaping_tag.production.id }]
   proxy_id         = peekaping_proxy.monitoring_proxy.id
 }
 
@@ -115,7 +115,7 @@ resource "peekaping_monitor" "website" {
   resend_interval  = 5
   active           = true
   notification_ids = [peekaping_notification.email_alerts.id]
-  tag_ids          = [peekaping_tag.production.id]
+  tags             = [{ tag_id = peekaping_tag.production.id }]
 }
 
 resource "peekaping_monitor" "database" {
@@ -132,7 +132,7 @@ resource "peekaping_monitor" "database" {
   resend_interval  = 3
   active           = true
   notification_ids = [peekaping_notification.email_alerts.id]
-  tag_ids          = [peekaping_tag.production.id]
+  tags             = [{ tag_id = peekaping_tag.production.id }]
 }
 
 resource "peekaping_monitor" "gateway" {
@@ -148,7 +148,7 @@ resource "peekaping_monitor" "gateway" {
   resend_interval  = 5
   active           = true
   notification_ids = [peekaping_notification.email_alerts.id]
-  tag_ids          = [peekaping_tag.production.id]
+  tags             = [{ tag_id = peekaping_tag.production.id }]
 }
 
 resource "peekaping_monitor" "dns_lookup" {
@@ -167,7 +167,7 @@ resource "peekaping_monitor" "dns_lookup" {
   resend_interval  = 3
   active           = true
   notification_ids = [peekaping_notification.email_alerts.id]
-  tag_ids          = [peekaping_tag.production.id]
+  tags             = [{ tag_id = peekaping_tag.production.id }]
 }
 
 # =============================================================================
@@ -175,16 +175,18 @@ resource "peekaping_monitor" "dns_lookup" {
 # =============================================================================
 
 resource "peekaping_maintenance" "scheduled_maintenance" {
-  title       = "Scheduled Maintenance"
-  description = "Regular maintenance window"
-  strategy    = "once"
-  timezone    = "UTC"
+  title           = "Scheduled Maintenance"
+  description     = "Regular maintenance window"
+  strategy        = "once"
+  start_date_time = "2030-01-01T02:00:00Z"
+  end_date_time   = "2030-01-01T04:00:00Z"
+  timezone        = "UTC"
 }
 
 resource "peekaping_maintenance" "weekly_maintenance" {
   title       = "Weekly Maintenance"
   description = "Weekly maintenance window"
-  strategy    = "recurring"
+  strategy    = "recurring-weekday"
   weekdays    = [0] # Sunday
   start_time  = "02:00"
   end_time    = "04:00"
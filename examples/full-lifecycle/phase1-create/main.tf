@@ -116,7 +116,7 @@ resource "peekaping_monitor" "api_health" {
   resend_interval  = 10
   active           = true
   notification_ids = [peekaping_notification.email_alerts.id]
-  tag_ids          = [peekaping_tag.production.id]
+  tags             = [{ tag_id = peekaping_tag.production.id }]
   proxy_id         = peekaping_proxy.monitoring_proxy.id
 }
 
@@ -137,7 +137,7 @@ resource "peekaping_monitor" "website" {
   resend_interval  = 5
   active           = true
   notification_ids = [peekaping_notification.email_alerts.id]
-  tag_ids          = [peekaping_tag.production.id]
+  tags             = [{ tag_id = peekaping_tag.production.id }]
 }
 
 resource "peekaping_monitor" "database" {
@@ -154,7 +154,7 @@ resource "peekaping_monitor" "database" {
   resend_interval  = 3
   active           = true
   notification_ids = [peekaping_notification.email_alerts.id]
-  tag_ids          = [peekaping_tag.production.id]
+  tags             = [{ tag_id = peekaping_tag.production.id }]
 }
 
 resource "peekaping_monitor" "gateway" {
@@ -170,7 +170,7 @@ resource "peekaping_monitor" "gateway" {
   resend_interval  = 5
   active           = true
   notification_ids = [peekaping_notification.email_alerts.id]
-  tag_ids          = [peekaping_tag.production.id]
+  tags             = [{ tag_id = peekaping_tag.production.id }]
 }
 
 resource "peekaping_monitor" "dns_lookup" {
@@ -189,7 +189,7 @@ resource "peekaping_monitor" "dns_lookup" {
   resend_interval  = 3
   active           = true
   notification_ids = [peekaping_notification.email_alerts.id]
-  tag_ids          = [peekaping_tag.production.id]
+  tags             = [{ tag_id = peekaping_tag.production.id }]
 }
 
 resource "peekaping_monitor" "test_monitor" {
@@ -209,7 +209,7 @@ resource "peekaping_monitor" "test_monitor" {
   resend_interval  = 2
   active           = false
   notification_ids = [peekaping_notification.test_notification.id]
-  tag_ids          = [peekaping_tag.test_tag.id]
+  tags             = [{ tag_id = peekaping_tag.test_tag.id }]
 }
 
 # =============================================================================
@@ -217,16 +217,18 @@ resource "peekaping_monitor" "test_monitor" {
 # =============================================================================
 
 resource "peekaping_maintenance" "scheduled_maintenance" {
-  title       = "Scheduled Maintenance"
-  description = "Regular maintenance window"
-  strategy    = "once"
-  timezone    = "UTC"
+  title           = "Scheduled Maintenance"
+  description     = "Regular maintenance window"
+  strategy        = "once"
+  start_date_time = "2030-01-01T02:00:00Z"
+  end_date_time   = "2030-01-01T04:00:00Z"
+  timezone        = "UTC"
 }
 
 resource "peekaping_maintenance" "weekly_maintenance" {
   title       = "Weekly Maintenance"
   description = "Weekly maintenance window"
-  strategy    = "recurring"
+  strategy    = "recurring-weekday"
   weekdays    = [0] # Sunday
   start_time  = "02:00"
   end_time    = "04:00"
@@ -234,10 +236,12 @@ resource "peekaping_maintenance" "weekly_maintenance" {
 }
 
 resource "peekaping_maintenance" "test_maintenance" {
-  title       = "Test Maintenance"
-  description = "This maintenance will be modified in phase 2"
-  strategy    = "once"
-  timezone    = "UTC"
+  title           = "Test Maintenance"
+  description     = "This maintenance will be modified in phase 2"
+  strategy        = "once"
+  start_date_time = "2030-01-01T02:00:00Z"
+  end_date_time   = "2030-01-01T04:00:00Z"
+  timezone        = "UTC"
 }
 
 # =============================================================================
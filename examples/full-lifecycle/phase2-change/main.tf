@@ -119,7 +119,7 @@ resource "peekaping_monitor" "api_health" {
   resend_interval  = 10
   active           = true
   notification_ids = [peekaping_notification.email_alerts.id]
-  tag_ids          = [peekaping_tag.production.id]
+  tags             = [{ tag_id = peekaping_tag.production.id }]
   proxy_id         = peekaping_proxy.monitoring_proxy.id
 }
 
@@ -140,7 +140,7 @@ resource "peekaping_monitor" "website" {
   resend_interval  = 5
   active           = true
   notification_ids = [peekaping_notification.email_alerts.id]
-  tag_ids          = [peekaping_tag.production.id]
+  tags             = [{ tag_id = peekaping_tag.production.id }]
 }
 
 resource "peekaping_monitor" "database" {
@@ -157,7 +157,7 @@ resource "peekaping_monitor" "database" {
   resend_interval  = 3
   active           = true
   notification_ids = [peekaping_notification.email_alerts.id]
-  tag_ids          = [peekaping_tag.production.id]
+  tags             = [{ tag_id = peekaping_tag.production.id }]
 }
 
 resource "peekaping_monitor" "gateway" {
@@ -173,7 +173,7 @@ resource "peekaping_monitor" "gateway" {
   resend_interval  = 5
   active           = true
   notification_ids = [peekaping_notification.email_alerts.id]
-  tag_ids          = [peekaping_tag.production.id]
+  tags             = [{ tag_id = peekaping_tag.production.id }]
 }
 
 resource "peekaping_monitor" "dns_lookup" {
@@ -192,7 +192,7 @@ resource "peekaping_monitor" "dns_lookup" {
   resend_interval  = 3
   active           = true
   notification_ids = [peekaping_notification.email_alerts.id]
-  tag_ids          = [peekaping_tag.production.id]
+  tags             = [{ tag_id = peekaping_tag.production.id }]
 }
 
 # MODIFIED: Change test monitor properties
@@ -210,7 +210,7 @@ resource "peekaping_monitor" "test_monitor" {
   resend_interval  = 5    # Changed from 2 to 5
   active           = true # Changed from false to true
   notification_ids = [peekaping_notification.test_notification.id]
-  tag_ids          = [peekaping_tag.test_tag.id]
+  tags             = [{ tag_id = peekaping_tag.test_tag.id }]
 }
 
 # =============================================================================
@@ -218,16 +218,18 @@ resource "peekaping_monitor" "test_monitor" {
 # =============================================================================
 
 resource "peekaping_maintenance" "scheduled_maintenance" {
-  title       = "Scheduled Maintenance"
-  description = "Regular maintenance window"
-  strategy    = "once"
-  timezone    = "UTC"
+  title           = "Scheduled Maintenance"
+  description     = "Regular maintenance window"
+  strategy        = "once"
+  start_date_time = "2030-01-01T02:00:00Z"
+  end_date_time   = "2030-01-01T04:00:00Z"
+  timezone        = "UTC"
 }
 
 resource "peekaping_maintenance" "weekly_maintenance" {
   title       = "Weekly Maintenance"
   description = "Weekly maintenance window"
-  strategy    = "recurring"
+  strategy    = "recurring-weekday"
   weekdays    = [0] # Sunday
   start_time  = "02:00"
   end_time    = "04:00"
@@ -238,8 +240,8 @@ resource "peekaping_maintenance" "weekly_maintenance" {
 resource "peekaping_maintenance" "test_maintenance" {
   title       = "Modified Test Maintenance"
   description = "This maintenance has been modified in phase 2"
-  strategy    = "recurring"     # Changed from once to recurring
-  weekdays    = [1, 2, 3, 4, 5] # Monday to Friday
+  strategy    = "recurring-weekday" # Changed from once to recurring-weekday
+  weekdays    = [1, 2, 3, 4, 5]     # Monday to Friday
   start_time  = "01:00"
   end_time    = "03:00"
   timezone    = "UTC"
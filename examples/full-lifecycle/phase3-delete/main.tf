@@ -122,7 +122,7 @@ provider "peekaping" {
 #   resend_interval  = 10
 #   active           = true
 #   notification_ids = [peekaping_notification.email_alerts.id]
-#   tag_ids          = [peekaping_tag.production.id]
+#   tags             = [{ tag_id = peekaping_tag.production.id }]
 #   proxy_id         = peekaping_proxy.monitoring_proxy.id
 # }
 
@@ -143,7 +143,7 @@ provider "peekaping" {
 #   resend_interval  = 5
 #   active           = true
 #   notification_ids = [peekaping_notification.email_alerts.id]
-#   tag_ids          = [peekaping_tag.production.id]
+#   tags             = [{ tag_id = peekaping_tag.production.id }]
 # }
 
 # resource "peekaping_monitor" "database" {
@@ -160,7 +160,7 @@ provider "peekaping" {
 #   resend_interval  = 3
 #   active           = true
 #   notification_ids = [peekaping_notification.email_alerts.id]
-#   tag_ids          = [peekaping_tag.production.id]
+#   tags             = [{ tag_id = peekaping_tag.production.id }]
 # }
 
 # resource "peekaping_monitor" "gateway" {
@@ -176,7 +176,7 @@ provider "peekaping" {
 #   resend_interval  = 5
 #   active           = true
 #   notification_ids = [peekaping_notification.email_alerts.id]
-#   tag_ids          = [peekaping_tag.production.id]
+#   tags             = [{ tag_id = peekaping_tag.production.id }]
 # }
 
 # resource "peekaping_monitor" "dns_lookup" {
@@ -195,7 +195,7 @@ provider "peekaping" {
 #   resend_interval  = 3
 #   active           = true
 #   notification_ids = [peekaping_notification.email_alerts.id]
-#   tag_ids          = [peekaping_tag.production.id]
+#   tags             = [{ tag_id = peekaping_tag.production.id }]
 # }
 
 # resource "peekaping_monitor" "test_monitor" {
@@ -212,7 +212,7 @@ provider "peekaping" {
 #   resend_interval  = 5
 #   active           = true
 #   notification_ids = [peekaping_notification.test_notification.id]
-#   tag_ids          = [peekaping_tag.test_tag.id]
+#   tags             = [{ tag_id = peekaping_tag.test_tag.id }]
 # }
 
 # resource "peekaping_maintenance" "scheduled_maintenance" {
@@ -229,7 +229,7 @@ provider "peekaping" {
 # resource "peekaping_maintenance" "weekly_maintenance" {
 #   title       = "Weekly Maintenance"
 #   description = "Weekly maintenance window"
-#   strategy    = "recurring"
+#   strategy    = "recurring-weekday"
 #   active      = true
 #   monitor_ids = [peekaping_monitor.database.id]
 #   weekdays    = [0] # Sunday
@@ -241,7 +241,7 @@ provider "peekaping" {
 # resource "peekaping_maintenance" "test_maintenance" {
 #   title       = "Modified Test Maintenance"
 #   description = "This maintenance has been modified in phase 2"
-#   strategy    = "recurring"
+#   strategy    = "recurring-weekday"
 #   active      = true
 #   monitor_ids = [peekaping_monitor.test_monitor.id]
 #   weekdays    = [1, 2, 3, 4, 5] # Monday to Friday
@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -75,61 +76,37 @@ type refreshResponse struct {
 	Message string `json:"message"`
 }
 
+// listResponse is a page of objects returned by a list endpoint.
+type listResponse[T any] struct {
+	Data    []T    `json:"data"`
+	Message string `json:"message"`
+}
+
 type monitorResponse struct {
 	Data    Monitor `json:"data"`
 	Message string  `json:"message"`
 }
 
-type monitorsResponse struct {
-	Data    []Monitor `json:"data"`
-	Message string    `json:"message"`
-}
-
-type notificationsResponse struct {
-	Data    []Notification `json:"data"`
-	Message string         `json:"message"`
-}
-
 type notificationResponse struct {
 	Data    Notification `json:"data"`
 	Message string       `json:"message"`
 }
 
-type tagsResponse struct {
-	Data    []Tag  `json:"data"`
-	Message string `json:"message"`
-}
-
 type tagResponse struct {
 	Data    Tag    `json:"data"`
 	Message string `json:"message"`
 }
 
-type maintenancesResponse struct {
-	Data    []Maintenance `json:"data"`
-	Message string        `json:"message"`
-}
-
 type maintenanceResponse struct {
 	Data    Maintenance `json:"data"`
 	Message string      `json:"message"`
 }
 
-type statusPagesResponse struct {
-	Data    []StatusPage `json:"data"`
-	Message string       `json:"message"`
-}
-
 type statusPageResponse struct {
 	Data    StatusPage `json:"data"`
 	Message string     `json:"message"`
 }
 
-type proxiesResponse struct {
-	Data    []Proxy `json:"data"`
-	Message string  `json:"message"`
-}
-
 type proxyResponse struct {
 	Data    Proxy  `json:"data"`
 	Message string `json:"message"`
@@ -179,6 +156,22 @@ func (c *Client) newReq(ctx context.Context, method, path string, body any) (*ht
 	return req, nil
 }
 
+// HTTPError is returned for API responses with a non-2xx status.
+type HTTPError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("http %d: %s", e.StatusCode, e.Message)
+}
+
+// IsNotFound reports whether err is an API response with status 404.
+func IsNotFound(err error) bool {
+	var httpErr *HTTPError
+	return errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound
+}
+
 func (c *Client) do(req *http.Request, out any) error {
 	res, err := c.HTTP.Do(req)
 	if err != nil {
@@ -206,11 +199,11 @@ func (c *Client) do(req *http.Request, out any) error {
 				Data    any    `json:"data"`
 			}
 			if err := json.Unmarshal(b, &errorResp); err == nil && errorResp.Message != "" {
-				return fmt.Errorf("http %d: %s", res2.StatusCode, errorResp.Message)
+				return &HTTPError{StatusCode: res2.StatusCode, Message: errorResp.Message}
 			}
 
 			// Fallback to raw response
-			return fmt.Errorf("http %d: %s", res2.StatusCode, string(b))
+			return &HTTPError{StatusCode: res2.StatusCode, Message: string(b)}
 		}
 		if out == nil {
 			return nil
@@ -239,11 +232,11 @@ func (c *Client) do(req *http.Request, out any) error {
 			Data    any    `json:"data"`
 		}
 		if err := json.Unmarshal(b, &errorResp); err == nil && errorResp.Message != "" {
-			return fmt.Errorf("http %d: %s", res.StatusCode, errorResp.Message)
+			return &HTTPError{StatusCode: res.StatusCode, Message: errorResp.Message}
 		}
 
 		// Fallback to raw response
-		return fmt.Errorf("http %d: %s", res.StatusCode, string(b))
+		return &HTTPError{StatusCode: res.StatusCode, Message: string(b)}
 	}
 	if out == nil {
 		return nil
@@ -272,6 +265,29 @@ func (c *Client) refresh(ctx context.Context) error {
 	return nil
 }
 
+// Tokens is an access/refresh token pair issued by a login.
+type Tokens struct {
+	AccessToken  string
+	RefreshToken string
+}
+
+// IssueTokens logs in and returns a new token pair without touching the client's own
+// session. Empty email and password fall back to the client's credentials.
+func (c *Client) IssueTokens(ctx context.Context, email, password, totpToken string) (*Tokens, error) {
+	if email == "" && password == "" {
+		email, password, totpToken = c.email, c.password, c.totpToken
+	}
+	if email == "" || password == "" {
+		return nil, fmt.Errorf("email and password are required to issue an access token")
+	}
+	s := New(c.Endpoint, WithCredentials(email, password), WithTotpToken(totpToken))
+	s.HTTP = c.HTTP
+	if err := s.Login(ctx); err != nil {
+		return nil, err
+	}
+	return &Tokens{AccessToken: s.accessToken, RefreshToken: s.refreshToken}, nil
+}
+
 // ---- API: Monitors ----
 
 type MonitorType string
@@ -329,25 +345,33 @@ type Monitor struct {
 	PushToken       string        `json:"push_token,omitempty"`
 	NotificationIDs []string      `json:"notification_ids,omitempty"`
 	TagIDs          []string      `json:"tag_ids,omitempty"`
+	Tags            []MonitorTag  `json:"tags,omitempty"`
 	Status          MonitorStatus `json:"status,omitempty"`
 	CreatedAt       string        `json:"created_at,omitempty"`
 	UpdatedAt       string        `json:"updated_at,omitempty"`
 }
 
+// MonitorTag is a tag assignment on a monitor, optionally carrying a per-monitor value.
+type MonitorTag struct {
+	TagID string `json:"tag_id"`
+	Value string `json:"value,omitempty"`
+}
+
 type MonitorCreate struct {
-	Name            string      `json:"name"`
-	Type            MonitorType `json:"type"`
-	Config          string      `json:"config,omitempty"`
-	Interval        int64       `json:"interval,omitempty"`
-	Active          bool        `json:"active,omitempty"`
-	Timeout         int64       `json:"timeout,omitempty"`
-	MaxRetries      int64       `json:"max_retries,omitempty"`
-	RetryInterval   int64       `json:"retry_interval,omitempty"`
-	ResendInterval  int64       `json:"resend_interval,omitempty"`
-	ProxyID         string      `json:"proxy_id,omitempty"`
-	PushToken       string      `json:"push_token,omitempty"`
-	NotificationIDs []string    `json:"notification_ids"`
-	TagIDs          []string    `json:"tag_ids,omitempty"`
+	Name            string       `json:"name"`
+	Type            MonitorType  `json:"type"`
+	Config          string       `json:"config,omitempty"`
+	Interval        int64        `json:"interval,omitempty"`
+	Active          bool         `json:"active,omitempty"`
+	Timeout         int64        `json:"timeout,omitempty"`
+	MaxRetries      int64        `json:"max_retries,omitempty"`
+	RetryInterval   int64        `json:"retry_interval,omitempty"`
+	ResendInterval  int64        `json:"resend_interval,omitempty"`
+	ProxyID         string       `json:"proxy_id,omitempty"`
+	PushToken       string       `json:"push_token,omitempty"`
+	NotificationIDs []string     `json:"notification_ids"`
+	TagIDs          []string     `json:"tag_ids,omitempty"`
+	Tags            []MonitorTag `json:"tags,omitempty"`
 }
 
 type MonitorUpdate struct {
@@ -362,8 +386,9 @@ type MonitorUpdate struct {
 	ResendInterval  *int64       `json:"resend_interval,omitempty"`
 	ProxyID         *string      `json:"proxy_id,omitempty"`
 	PushToken       *string      `json:"push_token,omitempty"`
-	NotificationIDs []string     `json:"notification_ids,omitempty"`
-	TagIDs          []string     `json:"tag_ids,omitempty"`
+	NotificationIDs []string     `json:"notification_ids"`
+	TagIDs          []string     `json:"tag_ids"`
+	Tags            []MonitorTag `json:"tags"`
 }
 
 type ListMonitorsResp struct {
@@ -374,15 +399,11 @@ type ListMonitorsResp struct {
 }
 
 func (c *Client) ListMonitors(ctx context.Context) (*ListMonitorsResp, error) {
-	req, err := c.newReq(ctx, http.MethodGet, "/monitors", nil)
+	items, err := listAll(ctx, c, "/monitors", func(x *Monitor) string { return x.ID })
 	if err != nil {
 		return nil, err
 	}
-	var out monitorsResponse
-	if err := c.do(req, &out); err != nil {
-		return nil, err
-	}
-	return &ListMonitorsResp{Items: out.Data, Total: len(out.Data)}, nil
+	return &ListMonitorsResp{Items: items, Total: len(items)}, nil
 }
 
 func (c *Client) CreateMonitor(ctx context.Context, in MonitorCreate) (*Monitor, error) {
@@ -436,6 +457,215 @@ func (c *Client) DeleteMonitor(ctx context.Context, id string) error {
 	return c.do(req, nil)
 }
 
+// PauseMonitor stops a monitor from running checks.
+func (c *Client) PauseMonitor(ctx context.Context, id string) (*Monitor, error) {
+	return c.setMonitorActive(ctx, id, false)
+}
+
+// ResumeMonitor restarts checks of a paused monitor.
+func (c *Client) ResumeMonitor(ctx context.Context, id string) (*Monitor, error) {
+	return c.setMonitorActive(ctx, id, true)
+}
+
+// setMonitorActive flips the active flag with a partial update. Peekaping has no separate
+// pause or resume route; its web UI pauses monitors the same way.
+func (c *Client) setMonitorActive(ctx context.Context, id string, active bool) (*Monitor, error) {
+	req, err := c.newReq(ctx, http.MethodPatch, "/monitors/"+url.PathEscape(id), map[string]bool{"active": active})
+	if err != nil {
+		return nil, err
+	}
+	var out monitorResponse
+	if err := c.do(req, &out); err != nil {
+		return nil, err
+	}
+	return &out.Data, nil
+}
+
+// PushURL returns the URL that push monitors receive heartbeats on.
+func (c *Client) PushURL(token string) string {
+	return c.Endpoint + apiPrefix + "/push/" + url.PathEscape(token)
+}
+
+// ---- Paging ----
+
+// listPageSize is the page size used when paging through list endpoints.
+const listPageSize = 100
+
+// listAll fetches every page of a list endpoint. Paging stops at the first short page,
+// or when a page starts with an object already seen, in case the server ignores paging.
+func listAll[T any](ctx context.Context, c *Client, path string, id func(*T) string) ([]T, error) {
+	items := []T{}
+	seen := make(map[string]bool)
+	for page := 0; ; page++ {
+		q := url.Values{}
+		q.Set("limit", fmt.Sprint(listPageSize))
+		q.Set("page", fmt.Sprint(page))
+		req, err := c.newReq(ctx, http.MethodGet, path+"?"+q.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+		var out listResponse[T]
+		if err := c.do(req, &out); err != nil {
+			return nil, err
+		}
+		if len(out.Data) > 0 && seen[id(&out.Data[0])] {
+			return items, nil
+		}
+		for i := range out.Data {
+			seen[id(&out.Data[i])] = true
+		}
+		items = append(items, out.Data...)
+		if len(out.Data) < listPageSize {
+			return items, nil
+		}
+	}
+}
+
+// ---- Timestamps ----
+
+// serverTimeLayouts are the timestamp formats the server may return, tried in order.
+var serverTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04",
+}
+
+// ParseServerTime parses a timestamp in any of the formats the server returns.
+// Timestamps without an offset are read in loc.
+func ParseServerTime(s string, loc *time.Location) (time.Time, error) {
+	for _, layout := range serverTimeLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognized timestamp %q", s)
+}
+
+// ---- API: Heartbeats ----
+
+// heartbeatPageSize is the page size used when paging through heartbeat history.
+const heartbeatPageSize = 100
+
+type Heartbeat struct {
+	ID        string        `json:"id"`
+	MonitorID string        `json:"monitor_id"`
+	Status    MonitorStatus `json:"status"`
+	Msg       string        `json:"msg,omitempty"`
+	Ping      int64         `json:"ping,omitempty"`
+	Duration  int64         `json:"duration,omitempty"`
+	Important bool          `json:"important,omitempty"`
+	Time      string        `json:"time"`
+	EndTime   string        `json:"end_time,omitempty"`
+}
+
+type heartbeatsResponse struct {
+	Data    []Heartbeat `json:"data"`
+	Message string      `json:"message"`
+}
+
+type ListHeartbeatsResp struct {
+	Items []Heartbeat `json:"items"`
+	Total int         `json:"total"`
+}
+
+// ListHeartbeats returns up to limit heartbeats of a monitor, newest first, that
+// happened at or after since. A zero since returns the most recent beats regardless
+// of age. Pages are fetched until the window or the limit is exhausted.
+func (c *Client) ListHeartbeats(ctx context.Context, monitorID string, since time.Time, limit int) (*ListHeartbeatsResp, error) {
+	items := []Heartbeat{}
+	for page := 0; limit <= 0 || len(items) < limit; page++ {
+		q := url.Values{}
+		q.Set("limit", fmt.Sprint(heartbeatPageSize))
+		q.Set("page", fmt.Sprint(page))
+		req, err := c.newReq(ctx, http.MethodGet, "/monitors/"+url.PathEscape(monitorID)+"/heartbeats?"+q.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+		var out heartbeatsResponse
+		if err := c.do(req, &out); err != nil {
+			return nil, err
+		}
+
+		for _, hb := range out.Data {
+			if !since.IsZero() {
+				t, err := ParseServerTime(hb.Time, time.UTC)
+				if err != nil {
+					return nil, fmt.Errorf("heartbeat %s: %w", hb.ID, err)
+				}
+				if t.Before(since) {
+					return &ListHeartbeatsResp{Items: items, Total: len(items)}, nil
+				}
+			}
+			items = append(items, hb)
+			if limit > 0 && len(items) == limit {
+				break
+			}
+		}
+		if len(out.Data) < heartbeatPageSize {
+			break
+		}
+	}
+	return &ListHeartbeatsResp{Items: items, Total: len(items)}, nil
+}
+
+// ---- API: Monitor statistics ----
+
+// MonitorUptimeStats holds uptime percentages (0-100) over rolling windows.
+type MonitorUptimeStats struct {
+	Uptime24h float64 `json:"24h"`
+	Uptime7d  float64 `json:"7d"`
+	Uptime30d float64 `json:"30d"`
+}
+
+type uptimeStatsResponse struct {
+	Data    MonitorUptimeStats `json:"data"`
+	Message string             `json:"message"`
+}
+
+func (c *Client) GetMonitorUptimeStats(ctx context.Context, monitorID string) (*MonitorUptimeStats, error) {
+	req, err := c.newReq(ctx, http.MethodGet, "/monitors/"+url.PathEscape(monitorID)+"/stats/uptime", nil)
+	if err != nil {
+		return nil, err
+	}
+	var out uptimeStatsResponse
+	if err := c.do(req, &out); err != nil {
+		return nil, err
+	}
+	return &out.Data, nil
+}
+
+// MonitorTLSInfo describes the certificate last seen by an HTTP monitor.
+type MonitorTLSInfo struct {
+	Valid         bool   `json:"valid"`
+	Issuer        string `json:"issuer,omitempty"`
+	ValidTo       string `json:"valid_to,omitempty"`
+	DaysRemaining int64  `json:"days_remaining"`
+}
+
+type tlsInfoResponse struct {
+	Data    MonitorTLSInfo `json:"data"`
+	Message string         `json:"message"`
+}
+
+// GetMonitorTLSInfo returns the certificate last seen by a monitor, or nil if the server
+// has none recorded.
+func (c *Client) GetMonitorTLSInfo(ctx context.Context, monitorID string) (*MonitorTLSInfo, error) {
+	req, err := c.newReq(ctx, http.MethodGet, "/monitors/"+url.PathEscape(monitorID)+"/tls", nil)
+	if err != nil {
+		return nil, err
+	}
+	var out tlsInfoResponse
+	if err := c.do(req, &out); err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &out.Data, nil
+}
+
 // ---- API: Notifications ----
 
 type Notification struct {
@@ -473,15 +703,11 @@ type ListNotificationsResp struct {
 }
 
 func (c *Client) ListNotifications(ctx context.Context) (*ListNotificationsResp, error) {
-	req, err := c.newReq(ctx, http.MethodGet, "/notification-channels", nil)
+	items, err := listAll(ctx, c, "/notification-channels", func(x *Notification) string { return x.ID })
 	if err != nil {
 		return nil, err
 	}
-	var out notificationsResponse
-	if err := c.do(req, &out); err != nil {
-		return nil, err
-	}
-	return &ListNotificationsResp{Items: out.Data, Total: len(out.Data)}, nil
+	return &ListNotificationsResp{Items: items, Total: len(items)}, nil
 }
 
 func (c *Client) CreateNotification(ctx context.Context, in NotificationCreate) (*Notification, error) {
@@ -528,6 +754,22 @@ func (c *Client) DeleteNotification(ctx context.Context, id string) error {
 	return c.do(req, nil)
 }
 
+// NotificationTest is a notification channel configuration to send a test message with.
+type NotificationTest struct {
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Config string `json:"config"`
+}
+
+// TestNotification sends a test message through the given channel configuration.
+func (c *Client) TestNotification(ctx context.Context, in NotificationTest) error {
+	req, err := c.newReq(ctx, http.MethodPost, "/notification-channels/test", in)
+	if err != nil {
+		return err
+	}
+	return c.do(req, nil)
+}
+
 // ---- API: Tags ----
 
 type Tag struct {
@@ -559,15 +801,11 @@ type ListTagsResp struct {
 }
 
 func (c *Client) ListTags(ctx context.Context) (*ListTagsResp, error) {
-	req, err := c.newReq(ctx, http.MethodGet, "/tags", nil)
+	items, err := listAll(ctx, c, "/tags", func(x *Tag) string { return x.ID })
 	if err != nil {
 		return nil, err
 	}
-	var out tagsResponse
-	if err := c.do(req, &out); err != nil {
-		return nil, err
-	}
-	return &ListTagsResp{Items: out.Data, Total: len(out.Data)}, nil
+	return &ListTagsResp{Items: items, Total: len(items)}, nil
 }
 
 func (c *Client) CreateTag(ctx context.Context, in TagCreate) (*Tag, error) {
@@ -633,40 +871,45 @@ type Maintenance struct {
 	IntervalDay   int      `json:"interval_day,omitempty"`
 	StartTime     string   `json:"start_time,omitempty"`
 	EndTime       string   `json:"end_time,omitempty"`
+	Status        string   `json:"status,omitempty"`
 	CreatedAt     string   `json:"created_at,omitempty"`
 	UpdatedAt     string   `json:"updated_at,omitempty"`
 }
 
 type MaintenanceCreate struct {
-	Title       string   `json:"title"`
-	Description string   `json:"description,omitempty"`
-	Strategy    string   `json:"strategy"`
-	Active      bool     `json:"active,omitempty"`
-	MonitorIDs  []string `json:"monitor_ids,omitempty"`
-	Duration    int      `json:"duration,omitempty"`
-	Timezone    string   `json:"timezone,omitempty"`
-	Cron        string   `json:"cron,omitempty"`
-	Weekdays    []int    `json:"weekdays,omitempty"`
-	DaysOfMonth []int    `json:"days_of_month,omitempty"`
-	IntervalDay int      `json:"interval_day,omitempty"`
-	StartTime   string   `json:"start_time,omitempty"`
-	EndTime     string   `json:"end_time,omitempty"`
+	Title         string   `json:"title"`
+	Description   string   `json:"description,omitempty"`
+	Strategy      string   `json:"strategy"`
+	Active        bool     `json:"active,omitempty"`
+	MonitorIDs    []string `json:"monitor_ids,omitempty"`
+	StartDateTime string   `json:"start_date_time,omitempty"`
+	EndDateTime   string   `json:"end_date_time,omitempty"`
+	Duration      int      `json:"duration,omitempty"`
+	Timezone      string   `json:"timezone,omitempty"`
+	Cron          string   `json:"cron,omitempty"`
+	Weekdays      []int    `json:"weekdays,omitempty"`
+	DaysOfMonth   []int    `json:"days_of_month,omitempty"`
+	IntervalDay   int      `json:"interval_day,omitempty"`
+	StartTime     string   `json:"start_time,omitempty"`
+	EndTime       string   `json:"end_time,omitempty"`
 }
 
 type MaintenanceUpdate struct {
-	Title       *string  `json:"title,omitempty"`
-	Description *string  `json:"description,omitempty"`
-	Strategy    *string  `json:"strategy,omitempty"`
-	Active      *bool    `json:"active,omitempty"`
-	MonitorIDs  []string `json:"monitor_ids,omitempty"`
-	Duration    *int     `json:"duration,omitempty"`
-	Timezone    *string  `json:"timezone,omitempty"`
-	Cron        *string  `json:"cron,omitempty"`
-	Weekdays    []int    `json:"weekdays,omitempty"`
-	DaysOfMonth []int    `json:"days_of_month,omitempty"`
-	IntervalDay *int     `json:"interval_day,omitempty"`
-	StartTime   *string  `json:"start_time,omitempty"`
-	EndTime     *string  `json:"end_time,omitempty"`
+	Title         *string  `json:"title,omitempty"`
+	Description   *string  `json:"description,omitempty"`
+	Strategy      *string  `json:"strategy,omitempty"`
+	Active        *bool    `json:"active,omitempty"`
+	MonitorIDs    []string `json:"monitor_ids"`
+	StartDateTime *string  `json:"start_date_time,omitempty"`
+	EndDateTime   *string  `json:"end_date_time,omitempty"`
+	Duration      *int     `json:"duration,omitempty"`
+	Timezone      *string  `json:"timezone,omitempty"`
+	Cron          *string  `json:"cron,omitempty"`
+	Weekdays      []int    `json:"weekdays"`
+	DaysOfMonth   []int    `json:"days_of_month"`
+	IntervalDay   *int     `json:"interval_day,omitempty"`
+	StartTime     *string  `json:"start_time,omitempty"`
+	EndTime       *string  `json:"end_time,omitempty"`
 }
 
 type ListMaintenanceResp struct {
@@ -677,15 +920,11 @@ type ListMaintenanceResp struct {
 }
 
 func (c *Client) ListMaintenance(ctx context.Context) (*ListMaintenanceResp, error) {
-	req, err := c.newReq(ctx, http.MethodGet, "/maintenances", nil)
+	items, err := listAll(ctx, c, "/maintenances", func(x *Maintenance) string { return x.ID })
 	if err != nil {
 		return nil, err
 	}
-	var out maintenancesResponse
-	if err := c.do(req, &out); err != nil {
-		return nil, err
-	}
-	return &ListMaintenanceResp{Items: out.Data, Total: len(out.Data)}, nil
+	return &ListMaintenanceResp{Items: items, Total: len(items)}, nil
 }
 
 func (c *Client) CreateMaintenance(ctx context.Context, in MaintenanceCreate) (*Maintenance, error) {
@@ -803,15 +1042,11 @@ type ListStatusPagesResp struct {
 }
 
 func (c *Client) ListStatusPages(ctx context.Context) (*ListStatusPagesResp, error) {
-	req, err := c.newReq(ctx, http.MethodGet, "/status-pages", nil)
+	items, err := listAll(ctx, c, "/status-pages", func(x *StatusPage) string { return x.ID })
 	if err != nil {
 		return nil, err
 	}
-	var out statusPagesResponse
-	if err := c.do(req, &out); err != nil {
-		return nil, err
-	}
-	return &ListStatusPagesResp{Items: out.Data, Total: len(out.Data)}, nil
+	return &ListStatusPagesResp{Items: items, Total: len(items)}, nil
 }
 
 func (c *Client) CreateStatusPage(ctx context.Context, in StatusPageCreate) (*StatusPage, error) {
@@ -919,15 +1154,11 @@ type ListProxiesResp struct {
 }
 
 func (c *Client) ListProxies(ctx context.Context) (*ListProxiesResp, error) {
-	req, err := c.newReq(ctx, http.MethodGet, "/proxies", nil)
+	items, err := listAll(ctx, c, "/proxies", func(x *Proxy) string { return x.ID })
 	if err != nil {
 		return nil, err
 	}
-	var out proxiesResponse
-	if err := c.do(req, &out); err != nil {
-		return nil, err
-	}
-	return &ListProxiesResp{Items: out.Data, Total: len(out.Data)}, nil
+	return &ListProxiesResp{Items: items, Total: len(items)}, nil
 }
 
 func (c *Client) CreateProxy(ctx context.Context, in ProxyCreate) (*Proxy, error) {
@@ -0,0 +1,236 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package peekaping
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// recordedRequest is a request received by the test server.
+type recordedRequest struct {
+	Method, Path, APIKey string
+	Body                 map[string]any
+}
+
+// newTestServer returns a client for a server that records each request and answers
+// with status and body.
+func newTestServer(t *testing.T, status int, body string) (*Client, *[]recordedRequest) {
+	t.Helper()
+	var requests []recordedRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := recordedRequest{Method: r.Method, Path: r.URL.Path, APIKey: r.Header.Get("X-API-Key")}
+		b, _ := io.ReadAll(r.Body)
+		if len(b) > 0 {
+			if err := json.Unmarshal(b, &req.Body); err != nil {
+				t.Errorf("request body is not JSON: %s", b)
+			}
+		}
+		requests = append(requests, req)
+		w.WriteHeader(status)
+		_, _ = io.WriteString(w, body)
+	}))
+	t.Cleanup(srv.Close)
+	return New(srv.URL, WithApiKey("key")), &requests
+}
+
+// TestPauseResumeMonitor tests that pause and resume PATCH only the active flag.
+func TestPauseResumeMonitor(t *testing.T) {
+	ctx := context.Background()
+	for _, tt := range []struct {
+		name   string
+		call   func(*Client) (*Monitor, error)
+		active bool
+	}{
+		{"pause", func(c *Client) (*Monitor, error) { return c.PauseMonitor(ctx, "mon-1") }, false},
+		{"resume", func(c *Client) (*Monitor, error) { return c.ResumeMonitor(ctx, "mon-1") }, true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			c, requests := newTestServer(t, http.StatusOK, `{"data":{"id":"mon-1","name":"api","active":`+strconv.FormatBool(tt.active)+`}}`)
+			m, err := tt.call(c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if m.ID != "mon-1" || m.Active != tt.active {
+				t.Errorf("unexpected monitor %+v", m)
+			}
+			if len(*requests) != 1 {
+				t.Fatalf("expected 1 request, got %d", len(*requests))
+			}
+			req := (*requests)[0]
+			if req.Method != http.MethodPatch || req.Path != "/api/v1/monitors/mon-1" || req.APIKey != "key" {
+				t.Errorf("unexpected request %s %s (api key %q)", req.Method, req.Path, req.APIKey)
+			}
+			if len(req.Body) != 1 || req.Body["active"] != tt.active {
+				t.Errorf("expected only active=%v to be sent, got %v", tt.active, req.Body)
+			}
+		})
+	}
+
+	c, _ := newTestServer(t, http.StatusNotFound, `{"message":"monitor not found"}`)
+	if _, err := c.PauseMonitor(ctx, "missing"); !IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+// TestTestNotification tests sending a test notification and surfacing its failure.
+func TestTestNotification(t *testing.T) {
+	ctx := context.Background()
+	in := NotificationTest{Name: "ops", Type: "slack", Config: `{"webhook_url":"https://hooks.example.com/x"}`}
+
+	c, requests := newTestServer(t, http.StatusOK, `{"message":"sent"}`)
+	if err := c.TestNotification(ctx, in); err != nil {
+		t.Fatal(err)
+	}
+	req := (*requests)[0]
+	if req.Method != http.MethodPost || req.Path != "/api/v1/notification-channels/test" {
+		t.Errorf("unexpected request %s %s", req.Method, req.Path)
+	}
+	if req.Body["name"] != in.Name || req.Body["type"] != in.Type || req.Body["config"] != in.Config {
+		t.Errorf("unexpected body %v", req.Body)
+	}
+
+	c, _ = newTestServer(t, http.StatusBadRequest, `{"message":"invalid webhook"}`)
+	err := c.TestNotification(ctx, in)
+	if err == nil || err.Error() != "http 400: invalid webhook" {
+		t.Errorf("expected the server message, got %v", err)
+	}
+}
+
+// newPagedServer returns a client for a server that answers each GET with the items
+// page returns for its page and limit query, and the queries it received.
+func newPagedServer[T any](t *testing.T, page func(page, limit int) []T) (*Client, *[]url.Values) {
+	t.Helper()
+	var queries []url.Values
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		queries = append(queries, q)
+		p, _ := strconv.Atoi(q.Get("page"))
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": page(p, limit)})
+	}))
+	t.Cleanup(srv.Close)
+	return New(srv.URL, WithApiKey("key")), &queries
+}
+
+// pageOf returns the items on page of a list paged by limit.
+func pageOf[T any](items []T, page, limit int) []T {
+	start := min(page*limit, len(items))
+	return items[start:min(start+limit, len(items))]
+}
+
+// TestListHeartbeats tests paging through heartbeats and stopping at since and limit.
+func TestListHeartbeats(t *testing.T) {
+	ctx := context.Background()
+	newest := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	// 250 beats a minute apart, newest first, in the server's timestamp format
+	beats := make([]Heartbeat, 250)
+	for i := range beats {
+		beats[i] = Heartbeat{ID: fmt.Sprint(i), Status: 1, Time: newest.Add(-time.Duration(i) * time.Minute).Format("2006-01-02 15:04:05")}
+	}
+
+	tests := []struct {
+		name      string
+		since     time.Time
+		limit     int
+		wantBeats int
+		wantPages int
+	}{
+		{"all", time.Time{}, 0, 250, 3},
+		{"limit", time.Time{}, 150, 150, 2},
+		{"limit on page boundary", time.Time{}, 100, 100, 1},
+		{"since", newest.Add(-120 * time.Minute), 0, 121, 2},
+		{"since and limit", newest.Add(-120 * time.Minute), 50, 50, 1},
+		{"since before all beats", newest.Add(-24 * time.Hour), 0, 250, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, queries := newPagedServer(t, func(page, limit int) []Heartbeat { return pageOf(beats, page, limit) })
+			out, err := c.ListHeartbeats(ctx, "mon-1", tt.since, tt.limit)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(out.Items) != tt.wantBeats || out.Total != tt.wantBeats {
+				t.Errorf("got %d beats (total %d), want %d", len(out.Items), out.Total, tt.wantBeats)
+			}
+			if len(out.Items) > 0 && (out.Items[0].ID != "0" || out.Items[len(out.Items)-1].ID != fmt.Sprint(tt.wantBeats-1)) {
+				t.Errorf("beats out of order: first %s, last %s", out.Items[0].ID, out.Items[len(out.Items)-1].ID)
+			}
+			if len(*queries) != tt.wantPages {
+				t.Errorf("fetched %d pages, want %d", len(*queries), tt.wantPages)
+			}
+			for i, q := range *queries {
+				if q.Get("page") != fmt.Sprint(i) || q.Get("limit") != fmt.Sprint(heartbeatPageSize) {
+					t.Errorf("request %d has query %s", i, q.Encode())
+				}
+			}
+		})
+	}
+
+	c, _ := newPagedServer(t, func(int, int) []Heartbeat { return []Heartbeat{{ID: "bad", Time: "yesterday"}} })
+	if _, err := c.ListHeartbeats(ctx, "mon-1", newest, 0); err == nil || !strings.Contains(err.Error(), "heartbeat bad") {
+		t.Errorf("expected an error for an unparseable time, got %v", err)
+	}
+}
+
+// TestListAll tests paging through a list endpoint until a short page, and stopping
+// when the server ignores paging and repeats a page.
+func TestListAll(t *testing.T) {
+	ctx := context.Background()
+	tags := make([]Tag, 250)
+	for i := range tags {
+		tags[i] = Tag{ID: fmt.Sprint(i), Name: fmt.Sprint("tag-", i)}
+	}
+
+	tests := []struct {
+		name      string
+		page      func(page, limit int) []Tag
+		wantItems int
+		wantPages int
+	}{
+		{"short last page", func(page, limit int) []Tag { return pageOf(tags, page, limit) }, 250, 3},
+		{"full last page", func(page, limit int) []Tag { return pageOf(tags[:200], page, limit) }, 200, 3},
+		{"empty", func(int, int) []Tag { return nil }, 0, 1},
+		{"paging ignored", func(int, int) []Tag { return tags[:100] }, 100, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, queries := newPagedServer(t, tt.page)
+			out, err := c.ListTags(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(out.Items) != tt.wantItems || out.Total != tt.wantItems {
+				t.Errorf("got %d tags (total %d), want %d", len(out.Items), out.Total, tt.wantItems)
+			}
+			for i, tag := range out.Items {
+				if tag.ID != fmt.Sprint(i) {
+					t.Fatalf("tag %d has ID %s", i, tag.ID)
+				}
+			}
+			if len(*queries) != tt.wantPages {
+				t.Errorf("fetched %d pages, want %d", len(*queries), tt.wantPages)
+			}
+			for i, q := range *queries {
+				if q.Get("page") != fmt.Sprint(i) || q.Get("limit") != fmt.Sprint(listPageSize) {
+					t.Errorf("request %d has query %s", i, q.Encode())
+				}
+			}
+		})
+	}
+
+	c, _ := newTestServer(t, http.StatusInternalServerError, `{"message":"boom"}`)
+	if _, err := c.ListTags(ctx); err == nil {
+		t.Error("expected an error from a failing list request")
+	}
+}
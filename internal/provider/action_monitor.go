@@ -0,0 +1,109 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
+)
+
+var _ action.ActionWithConfigure = &MonitorActiveAction{}
+var _ action.ActionWithValidateConfig = &MonitorActiveAction{}
+
+// MonitorActiveAction pauses or resumes monitors, depending on active.
+type MonitorActiveAction struct {
+	client *peekaping.Client
+	active bool
+}
+
+func NewMonitorPauseAction() action.Action { return &MonitorActiveAction{active: false} }
+
+func NewMonitorResumeAction() action.Action { return &MonitorActiveAction{active: true} }
+
+type monitorActiveActionModel struct {
+	MonitorIDs []types.String `tfsdk:"monitor_ids"`
+}
+
+func (a *MonitorActiveAction) verb() string {
+	if a.active {
+		return "resume"
+	}
+	return "pause"
+}
+
+func (a *MonitorActiveAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitor_" + a.verb()
+}
+
+func (a *MonitorActiveAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	description := "Pauses monitors, e.g. for the duration of a deployment, by setting their server-side active flag to false. " +
+		"peekaping_monitor keeps active from its configuration, so the pause doesn't show in plans and any update of the monitor resumes it."
+	if a.active {
+		description = "Resumes paused monitors."
+	}
+	resp.Schema = schema.Schema{
+		Description: description,
+		Attributes: map[string]schema.Attribute{
+			"monitor_ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: fmt.Sprintf("IDs of the monitors to %s", a.verb()),
+			},
+		},
+	}
+}
+
+func (a *MonitorActiveAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*peekaping.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *peekaping.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	a.client = client
+}
+
+func (a *MonitorActiveAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var ids types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("monitor_ids"), &ids)...)
+	if resp.Diagnostics.HasError() || ids.IsUnknown() || ids.IsNull() {
+		return
+	}
+	if len(ids.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("monitor_ids"), "Invalid Monitor IDs", "At least one monitor ID is required.")
+	}
+}
+
+func (a *MonitorActiveAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data monitorActiveActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	set := a.client.PauseMonitor
+	if a.active {
+		set = a.client.ResumeMonitor
+	}
+	for _, id := range data.MonitorIDs {
+		m, err := set(ctx, id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(a.verb()+" failed", fmt.Sprintf("monitor %s: %s", id.ValueString(), err))
+			return
+		}
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("%sd monitor %q (%s)", a.verb(), m.Name, id.ValueString())})
+	}
+}
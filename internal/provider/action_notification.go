@@ -0,0 +1,77 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
+)
+
+var _ action.ActionWithConfigure = &NotificationTestAction{}
+
+type NotificationTestAction struct {
+	client *peekaping.Client
+}
+
+func NewNotificationTestAction() action.Action { return &NotificationTestAction{} }
+
+type notificationTestActionModel struct {
+	NotificationID types.String `tfsdk:"notification_id"`
+}
+
+func (a *NotificationTestAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_test"
+}
+
+func (a *NotificationTestAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Sends a test message through a notification channel.",
+		Attributes: map[string]schema.Attribute{
+			"notification_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the notification channel to test",
+			},
+		},
+	}
+}
+
+func (a *NotificationTestAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*peekaping.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *peekaping.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	a.client = client
+}
+
+func (a *NotificationTestAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data notificationTestActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	n, err := a.client.GetNotification(ctx, data.NotificationID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("read failed", err.Error())
+		return
+	}
+	if err := a.client.TestNotification(ctx, peekaping.NotificationTest{Name: n.Name, Type: n.Type, Config: n.Config}); err != nil {
+		resp.Diagnostics.AddError("test notification failed", fmt.Sprintf("notification %q: %s", n.Name, err))
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("sent test message through notification %q", n.Name)})
+}
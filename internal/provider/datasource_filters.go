@@ -0,0 +1,156 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// compileFilterRegex compiles an optional regex filter of a plural data source.
+// It returns nil when the filter is not set.
+func compileFilterRegex(v types.String, attr string, diags *diag.Diagnostics) *regexp.Regexp {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	re, err := regexp.Compile(v.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root(attr),
+			"Invalid Regular Expression",
+			fmt.Sprintf("%s is not a valid regular expression: %s", attr, err),
+		)
+		return nil
+	}
+	return re
+}
+
+func matchesRegex(re *regexp.Regexp, s string) bool {
+	return re == nil || re.MatchString(s)
+}
+
+func matchesString(f types.String, s string) bool {
+	return f.IsNull() || f.ValueString() == s
+}
+
+func matchesBool(f types.Bool, b bool) bool {
+	return f.IsNull() || f.ValueBool() == b
+}
+
+// Name match modes of the singular data sources.
+const (
+	matchExact           = "exact"
+	matchCaseInsensitive = "case_insensitive"
+	matchPrefix          = "prefix"
+	matchRegex           = "regex"
+)
+
+var matchModes = []string{matchExact, matchCaseInsensitive, matchPrefix, matchRegex}
+
+// matchModeValidator validates the match attribute of the singular data sources.
+type matchModeValidator struct{}
+
+func (v matchModeValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("Match mode must be one of: %s", strings.Join(matchModes, ", "))
+}
+
+func (v matchModeValidator) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("Match mode must be one of: `%s`", strings.Join(matchModes, "`, `"))
+}
+
+func (v matchModeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	mode := req.ConfigValue.ValueString()
+	for _, m := range matchModes {
+		if mode == m {
+			return
+		}
+	}
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Match Mode",
+		fmt.Sprintf("Match mode must be one of: %s, got: %s", strings.Join(matchModes, ", "), mode),
+	)
+}
+
+// newNameMatcher returns a predicate matching names against query in the given mode.
+// An empty mode means exact.
+func newNameMatcher(mode, query string) (func(string) bool, error) {
+	switch mode {
+	case "", matchExact:
+		return func(s string) bool { return s == query }, nil
+	case matchCaseInsensitive:
+		return func(s string) bool { return strings.EqualFold(s, query) }, nil
+	case matchPrefix:
+		return func(s string) bool { return strings.HasPrefix(s, query) }, nil
+	case matchRegex:
+		re, err := regexp.Compile(query)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression %q: %w", query, err)
+		}
+		return re.MatchString, nil
+	default:
+		return nil, fmt.Errorf("unknown match mode %q", mode)
+	}
+}
+
+// findUnique returns the single item whose name satisfies match. It fails when nothing
+// matches, and lists the candidates when more than one item does, so a lookup never
+// silently depends on list order.
+func findUnique[T any](items []T, key func(*T) (name, id string), match func(string) bool, kind, query string) (*T, error) {
+	var found []*T
+	for i := range items {
+		if name, _ := key(&items[i]); match(name) {
+			found = append(found, &items[i])
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("no %s matched %q", kind, query)
+	case 1:
+		return found[0], nil
+	}
+
+	candidates := make([]string, 0, len(found))
+	for _, it := range found {
+		name, id := key(it)
+		candidates = append(candidates, fmt.Sprintf("%q (id %s)", name, id))
+	}
+	return nil, fmt.Errorf("%q matched more than one %s: %s. Use a more specific name or match mode, or look up by id",
+		query, kind, strings.Join(candidates, ", "))
+}
+
+// lookupAttributes turns the computed item schema of an object into the schema of a
+// singular data source: id and the lookup key become optional inputs and match is added.
+func lookupAttributes(attrs map[string]schema.Attribute, key string) map[string]schema.Attribute {
+	attrs["id"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: attrs["id"].GetDescription(),
+	}
+	attrs[key] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: attrs[key].GetDescription(),
+	}
+	attrs["match"] = schema.StringAttribute{
+		Optional:    true,
+		Description: fmt.Sprintf("How %s is matched: exact (default), case_insensitive, prefix or regex. Exactly one object must match.", key),
+		Validators: []validator.String{
+			matchModeValidator{},
+		},
+	}
+	return attrs
+}
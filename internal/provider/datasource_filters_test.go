@@ -0,0 +1,187 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
+)
+
+// TestFindUniqueMonitor tests name match modes and ambiguity handling of singular data sources.
+func TestFindUniqueMonitor(t *testing.T) {
+	monitors := []peekaping.Monitor{
+		{ID: "1", Name: "payments-api-legacy"},
+		{ID: "2", Name: "api"},
+		{ID: "3", Name: "API-gateway"},
+		{ID: "4", Name: "api-internal"},
+	}
+	key := func(m *peekaping.Monitor) (string, string) { return m.Name, m.ID }
+
+	tests := []struct {
+		name      string
+		mode      string
+		query     string
+		wantID    string
+		wantError string
+	}{
+		{"Default is exact", "", "api", "2", ""},
+		{"Exact ignores substrings", matchExact, "payments", "", "no monitor matched"},
+		{"Case insensitive", matchCaseInsensitive, "api-GATEWAY", "3", ""},
+		{"Prefix unique", matchPrefix, "payments", "1", ""},
+		{"Prefix ambiguous", matchPrefix, "api", "", `"api" (id 2), "api-internal" (id 4)`},
+		{"Regex", matchRegex, "-internal$", "4", ""},
+		{"Regex ambiguous", matchRegex, "(?i)^api", "", "matched more than one monitor"},
+		{"Invalid regex", matchRegex, "(", "", "invalid regular expression"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match, err := newNameMatcher(tt.mode, tt.query)
+			var got *peekaping.Monitor
+			if err == nil {
+				got, err = findUnique(monitors, key, match, "monitor", tt.query)
+			}
+			if tt.wantError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.ID != tt.wantID {
+				t.Errorf("expected monitor %s, got %s", tt.wantID, got.ID)
+			}
+		})
+	}
+}
+
+// newListServer returns a client for a server that answers GET requests on the given
+// API paths with one page of items.
+func newListServer(t *testing.T, lists map[string]any) *peekaping.Client {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		items, ok := lists[strings.TrimPrefix(r.URL.Path, "/api/v1")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(map[string]string{"message": "not found"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": items})
+	}))
+	t.Cleanup(srv.Close)
+	return peekaping.New(srv.URL)
+}
+
+// checkFilterResult compares the IDs of filtered items, or the error, with the expected ones.
+func checkFilterResult[T any](t *testing.T, got []T, id func(*T) string, errs diag.Diagnostics, wantIDs []string, wantError string) {
+	t.Helper()
+	if wantError != "" {
+		if !errs.HasError() || !strings.Contains(errs[0].Detail(), wantError) {
+			t.Fatalf("expected error containing %q, got %v", wantError, errs)
+		}
+		return
+	}
+	if errs.HasError() {
+		t.Fatalf("unexpected error: %v", errs)
+	}
+	ids := []string{}
+	for i := range got {
+		ids = append(ids, id(&got[i]))
+	}
+	if !slices.Equal(ids, append([]string{}, wantIDs...)) {
+		t.Errorf("expected %v, got %v", wantIDs, ids)
+	}
+}
+
+// TestMonitorFilter tests the filters of the plural monitors data source.
+func TestMonitorFilter(t *testing.T) {
+	client := newListServer(t, map[string]any{
+		"/monitors": []peekaping.Monitor{
+			{ID: "1", Name: "api", Type: "http", Active: true, Status: 1, Tags: []peekaping.MonitorTag{{TagID: "t-prod"}, {TagID: "t-team", Value: "payments"}}},
+			{ID: "2", Name: "api-internal", Type: "http", Active: false, Status: 0, TagIDs: []string{"t-dev"}},
+			{ID: "3", Name: "db", Type: "postgres", Active: true, Status: 0, Tags: []peekaping.MonitorTag{{TagID: "t-prod", Value: "eu"}, {TagID: "t-team", Value: "storage"}}},
+			{ID: "4", Name: "API-gateway", Type: "http", Active: true, Status: 1},
+		},
+		"/tags": []peekaping.Tag{{ID: "t-prod", Name: "prod"}, {ID: "t-dev", Name: "dev"}, {ID: "t-team", Name: "team"}},
+	})
+
+	tests := []struct {
+		name      string
+		filter    monitorFilterModel
+		wantIDs   []string
+		wantError string
+	}{
+		{"No filters", monitorFilterModel{}, []string{"1", "2", "3", "4"}, ""},
+		{"Regex exact", monitorFilterModel{NameRegex: types.StringValue("^api$")}, []string{"1"}, ""},
+		{"Regex prefix", monitorFilterModel{NameRegex: types.StringValue("^api")}, []string{"1", "2"}, ""},
+		{"Regex case insensitive", monitorFilterModel{NameRegex: types.StringValue("(?i)^api-")}, []string{"2", "4"}, ""},
+		{"Regex substring", monitorFilterModel{NameRegex: types.StringValue("internal")}, []string{"2"}, ""},
+		{"Type", monitorFilterModel{Type: types.StringValue("postgres")}, []string{"3"}, ""},
+		{"Type is exact", monitorFilterModel{Type: types.StringValue("HTTP")}, nil, ""},
+		{"Tag by name", monitorFilterModel{Tag: types.StringValue("prod")}, []string{"1", "3"}, ""},
+		{"Tag by ID from tag_ids", monitorFilterModel{Tag: types.StringValue("t-dev")}, []string{"2"}, ""},
+		{"Tag value", monitorFilterModel{Tag: types.StringValue("team"), TagValue: types.StringValue("payments")}, []string{"1"}, ""},
+		{"Tag value on another tag", monitorFilterModel{Tag: types.StringValue("prod"), TagValue: types.StringValue("payments")}, nil, ""},
+		{"Tag value on any tag", monitorFilterModel{TagValue: types.StringValue("eu")}, []string{"3"}, ""},
+		{"Empty tag value", monitorFilterModel{Tag: types.StringValue("prod"), TagValue: types.StringValue("")}, []string{"1"}, ""},
+		{"Active", monitorFilterModel{Active: types.BoolValue(false)}, []string{"2"}, ""},
+		{"Status", monitorFilterModel{Status: types.Int64Value(1)}, []string{"1", "4"}, ""},
+		{"Combined", monitorFilterModel{NameRegex: types.StringValue("^api"), Active: types.BoolValue(true), Tag: types.StringValue("prod")}, []string{"1"}, ""},
+		{"No match", monitorFilterModel{NameRegex: types.StringValue("^web")}, nil, ""},
+		{"Unknown tag", monitorFilterModel{Tag: types.StringValue("staging")}, nil, ""},
+		{"Invalid regex", monitorFilterModel{NameRegex: types.StringValue("(")}, nil, "not a valid regular expression"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := tt.filter.find(context.Background(), client)
+			checkFilterResult(t, got, func(m *peekaping.Monitor) string { return m.ID }, diags.Errors(), tt.wantIDs, tt.wantError)
+		})
+	}
+}
+
+// TestNotificationFilter tests the filters of the plural notifications data source.
+func TestNotificationFilter(t *testing.T) {
+	client := newListServer(t, map[string]any{
+		"/notification-channels": []peekaping.Notification{
+			{ID: "1", Name: "ops-slack", Type: "slack", Active: true},
+			{ID: "2", Name: "ops-email", Type: "smtp", Active: true},
+			{ID: "3", Name: "dev-slack", Type: "slack", Active: false},
+		},
+	})
+
+	tests := []struct {
+		name      string
+		filter    notificationFilterModel
+		wantIDs   []string
+		wantError string
+	}{
+		{"No filters", notificationFilterModel{}, []string{"1", "2", "3"}, ""},
+		{"Regex exact", notificationFilterModel{NameRegex: types.StringValue("^ops-slack$")}, []string{"1"}, ""},
+		{"Regex prefix", notificationFilterModel{NameRegex: types.StringValue("^ops-")}, []string{"1", "2"}, ""},
+		{"Type", notificationFilterModel{Type: types.StringValue("slack")}, []string{"1", "3"}, ""},
+		{"Active", notificationFilterModel{Type: types.StringValue("slack"), Active: types.BoolValue(true)}, []string{"1"}, ""},
+		{"No match", notificationFilterModel{Type: types.StringValue("webhook")}, nil, ""},
+		{"Invalid regex", notificationFilterModel{NameRegex: types.StringValue("[")}, nil, "not a valid regular expression"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := tt.filter.find(context.Background(), client)
+			checkFilterResult(t, got, func(n *peekaping.Notification) string { return n.ID }, diags.Errors(), tt.wantIDs, tt.wantError)
+		})
+	}
+}
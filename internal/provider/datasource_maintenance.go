@@ -6,10 +6,10 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
@@ -24,10 +24,8 @@ type MaintenanceDataSource struct {
 func NewMaintenanceDataSource() datasource.DataSource { return &MaintenanceDataSource{} }
 
 type maintenanceDataSourceModel struct {
-	ID       types.String `tfsdk:"id"`
-	Title    types.String `tfsdk:"title"`
-	Strategy types.String `tfsdk:"strategy"`
-	Active   types.Bool   `tfsdk:"active"`
+	maintenanceItemModel
+	Match types.String `tfsdk:"match"`
 }
 
 func (d *MaintenanceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -36,12 +34,7 @@ func (d *MaintenanceDataSource) Metadata(_ context.Context, req datasource.Metad
 
 func (d *MaintenanceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"id":       schema.StringAttribute{Optional: true, Description: "Maintenance ID"},
-			"title":    schema.StringAttribute{Optional: true, Description: "Maintenance title"},
-			"strategy": schema.StringAttribute{Computed: true, Description: "Maintenance strategy"},
-			"active":   schema.BoolAttribute{Computed: true, Description: "Whether maintenance is active"},
-		},
+		Attributes: lookupAttributes(maintenanceItemAttributes(), "title"),
 	}
 }
 
@@ -73,10 +66,7 @@ func (d *MaintenanceDataSource) Read(ctx context.Context, req datasource.ReadReq
 			resp.Diagnostics.AddError("lookup by id failed", err.Error())
 			return
 		}
-		data.ID = types.StringValue(m.ID)
-		data.Title = types.StringValue(m.Title)
-		data.Strategy = types.StringValue(m.Strategy)
-		data.Active = types.BoolValue(m.Active)
+		data.maintenanceItemModel = maintenanceItemFromAPI(m)
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 		return
 	}
@@ -86,16 +76,16 @@ func (d *MaintenanceDataSource) Read(ctx context.Context, req datasource.ReadReq
 		resp.Diagnostics.AddError("list maintenance failed", err.Error())
 		return
 	}
-	title := strings.ToLower(data.Title.ValueString())
-	for _, m := range list.Items {
-		if strings.ToLower(m.Title) == title || (title != "" && strings.Contains(strings.ToLower(m.Title), title)) {
-			data.ID = types.StringValue(m.ID)
-			data.Title = types.StringValue(m.Title)
-			data.Strategy = types.StringValue(m.Strategy)
-			data.Active = types.BoolValue(m.Active)
-			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-			return
-		}
+	match, err := newNameMatcher(data.Match.ValueString(), data.Title.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("title"), "Invalid Match Pattern", err.Error())
+		return
+	}
+	m, err := findUnique(list.Items, func(x *peekaping.Maintenance) (string, string) { return x.Title, x.ID }, match, "maintenance window", data.Title.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("lookup failed", err.Error())
+		return
 	}
-	resp.Diagnostics.AddError("not found", "no maintenance window matched the given criteria")
+	data.maintenanceItemModel = maintenanceItemFromAPI(m)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
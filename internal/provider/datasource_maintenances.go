@@ -0,0 +1,218 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
+)
+
+var _ datasource.DataSource = &MaintenancesDataSource{}
+
+type MaintenancesDataSource struct {
+	client *peekaping.Client
+}
+
+func NewMaintenancesDataSource() datasource.DataSource { return &MaintenancesDataSource{} }
+
+type maintenancesDataSourceModel struct {
+	maintenanceFilterModel
+	Maintenances []maintenanceItemModel `tfsdk:"maintenances"`
+}
+
+// maintenanceFilterModel holds the filters shared by the maintenance windows data source and list resource.
+type maintenanceFilterModel struct {
+	NameRegex types.String `tfsdk:"name_regex"`
+	Strategy  types.String `tfsdk:"strategy"`
+	MonitorID types.String `tfsdk:"monitor_id"`
+	Active    types.Bool   `tfsdk:"active"`
+}
+
+// maintenanceItemModel is a maintenance window as exported by data sources.
+type maintenanceItemModel struct {
+	ID            types.String `tfsdk:"id"`
+	Title         types.String `tfsdk:"title"`
+	Description   types.String `tfsdk:"description"`
+	Strategy      types.String `tfsdk:"strategy"`
+	Active        types.Bool   `tfsdk:"active"`
+	MonitorIDs    types.List   `tfsdk:"monitor_ids"`
+	StartDateTime types.String `tfsdk:"start_date_time"`
+	EndDateTime   types.String `tfsdk:"end_date_time"`
+	Duration      types.Int64  `tfsdk:"duration"`
+	Timezone      types.String `tfsdk:"timezone"`
+	Cron          types.String `tfsdk:"cron"`
+	Weekdays      types.List   `tfsdk:"weekdays"`
+	DaysOfMonth   types.List   `tfsdk:"days_of_month"`
+	IntervalDay   types.Int64  `tfsdk:"interval_day"`
+	StartTime     types.String `tfsdk:"start_time"`
+	EndTime       types.String `tfsdk:"end_time"`
+	Status        types.String `tfsdk:"status"`
+	NextWindows   types.List   `tfsdk:"next_windows"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	UpdatedAt     types.String `tfsdk:"updated_at"`
+}
+
+// maintenanceItemAttributes returns the computed schema of maintenanceItemModel.
+func maintenanceItemAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id":              schema.StringAttribute{Computed: true, Description: "Maintenance ID"},
+		"title":           schema.StringAttribute{Computed: true, Description: "Maintenance title"},
+		"description":     schema.StringAttribute{Computed: true, Description: "Maintenance description"},
+		"strategy":        schema.StringAttribute{Computed: true, Description: "Scheduling strategy"},
+		"active":          schema.BoolAttribute{Computed: true, Description: "Whether the maintenance is active"},
+		"monitor_ids":     schema.ListAttribute{Computed: true, ElementType: types.StringType, Description: "Affected monitor IDs"},
+		"start_date_time": schema.StringAttribute{Computed: true, Description: "Start date and time"},
+		"end_date_time":   schema.StringAttribute{Computed: true, Description: "End date and time"},
+		"duration":        schema.Int64Attribute{Computed: true, Description: "Duration in minutes"},
+		"timezone":        schema.StringAttribute{Computed: true, Description: "Timezone"},
+		"cron":            schema.StringAttribute{Computed: true, Description: "Cron expression"},
+		"weekdays":        schema.ListAttribute{Computed: true, ElementType: types.Int64Type, Description: "Weekdays (0=Sunday)"},
+		"days_of_month":   schema.ListAttribute{Computed: true, ElementType: types.Int64Type, Description: "Days of the month"},
+		"interval_day":    schema.Int64Attribute{Computed: true, Description: "Interval in days"},
+		"start_time":      schema.StringAttribute{Computed: true, Description: "Daily start time"},
+		"end_time":        schema.StringAttribute{Computed: true, Description: "Daily end time"},
+		"status":          schema.StringAttribute{Computed: true, Description: "Current status: scheduled, under-maintenance, ended or inactive"},
+		"next_windows": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "Upcoming windows computed from the schedule, including one in effect",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"start": schema.StringAttribute{Computed: true, Description: "Window start (RFC 3339)"},
+					"end":   schema.StringAttribute{Computed: true, Description: "Window end (RFC 3339)"},
+				},
+			},
+		},
+		"created_at": schema.StringAttribute{Computed: true, Description: "Creation timestamp"},
+		"updated_at": schema.StringAttribute{Computed: true, Description: "Last update timestamp"},
+	}
+}
+
+func maintenanceItemFromAPI(m *peekaping.Maintenance) maintenanceItemModel {
+	status, nextWindows := maintenanceComputed(m, time.Now())
+	return maintenanceItemModel{
+		ID:            types.StringValue(m.ID),
+		Title:         types.StringValue(m.Title),
+		Description:   optionalString(m.Description),
+		Strategy:      types.StringValue(m.Strategy),
+		Active:        types.BoolValue(m.Active),
+		MonitorIDs:    toStringList(m.MonitorIDs),
+		StartDateTime: optionalString(normalizeDateTime(m.StartDateTime, m.Timezone)),
+		EndDateTime:   optionalString(normalizeDateTime(m.EndDateTime, m.Timezone)),
+		Duration:      types.Int64Value(int64(m.Duration)),
+		Timezone:      optionalString(m.Timezone),
+		Cron:          optionalString(m.Cron),
+		Weekdays:      toInt64List(m.Weekdays),
+		DaysOfMonth:   toInt64List(m.DaysOfMonth),
+		IntervalDay:   types.Int64Value(int64(m.IntervalDay)),
+		StartTime:     optionalString(m.StartTime),
+		EndTime:       optionalString(m.EndTime),
+		Status:        status,
+		NextWindows:   nextWindows,
+		CreatedAt:     optionalString(m.CreatedAt),
+		UpdatedAt:     optionalString(m.UpdatedAt),
+	}
+}
+
+// toInt64List converts an int slice from the API into a Terraform list.
+func toInt64List(xs []int) types.List {
+	vals := make([]attr.Value, 0, len(xs))
+	for _, x := range xs {
+		vals = append(vals, types.Int64Value(int64(x)))
+	}
+	return types.ListValueMust(types.Int64Type, vals)
+}
+
+func (d *MaintenancesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_maintenances"
+}
+
+func (d *MaintenancesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists maintenance windows, optionally filtered.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{Optional: true, Description: "Only return maintenance windows whose title matches this regular expression"},
+			"strategy":   schema.StringAttribute{Optional: true, Description: "Only return maintenance windows with this strategy"},
+			"monitor_id": schema.StringAttribute{Optional: true, Description: "Only return maintenance windows covering this monitor"},
+			"active":     schema.BoolAttribute{Optional: true, Description: "Only return active (true) or inactive (false) maintenance windows"},
+			"maintenances": schema.ListNestedAttribute{
+				Computed:     true,
+				Description:  "Matching maintenance windows",
+				NestedObject: schema.NestedAttributeObject{Attributes: maintenanceItemAttributes()},
+			},
+		},
+	}
+}
+
+func (d *MaintenancesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*peekaping.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *peekaping.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *MaintenancesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data maintenancesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, diags := data.find(ctx, d.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Maintenances = make([]maintenanceItemModel, 0, len(items))
+	for i := range items {
+		data.Maintenances = append(data.Maintenances, maintenanceItemFromAPI(&items[i]))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// find returns the maintenance windows matching f.
+func (f *maintenanceFilterModel) find(ctx context.Context, client *peekaping.Client) ([]peekaping.Maintenance, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	nameRe := compileFilterRegex(f.NameRegex, "name_regex", &diags)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	list, err := client.ListMaintenance(ctx)
+	if err != nil {
+		diags.AddError("list maintenance failed", err.Error())
+		return nil, diags
+	}
+
+	var out []peekaping.Maintenance
+	for i := range list.Items {
+		m := &list.Items[i]
+		if !matchesRegex(nameRe, m.Title) || !matchesString(f.Strategy, m.Strategy) || !matchesBool(f.Active, m.Active) {
+			continue
+		}
+		if !f.MonitorID.IsNull() && !slices.Contains(m.MonitorIDs, f.MonitorID.ValueString()) {
+			continue
+		}
+		out = append(out, *m)
+	}
+	return out, diags
+}
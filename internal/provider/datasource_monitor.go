@@ -6,11 +6,10 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
@@ -25,10 +24,8 @@ type MonitorDataSource struct {
 func NewMonitorDataSource() datasource.DataSource { return &MonitorDataSource{} }
 
 type monitorDataSourceModel struct {
-	ID     types.String         `tfsdk:"id"`
-	Name   types.String         `tfsdk:"name"`
-	Type   types.String         `tfsdk:"type"`
-	Config jsontypes.Normalized `tfsdk:"config"`
+	monitorItemModel
+	Match types.String `tfsdk:"match"`
 }
 
 func (d *MonitorDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -37,16 +34,7 @@ func (d *MonitorDataSource) Metadata(_ context.Context, req datasource.MetadataR
 
 func (d *MonitorDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"id":   schema.StringAttribute{Optional: true, Description: "Monitor ID"},
-			"name": schema.StringAttribute{Optional: true, Description: "Monitor name"},
-			"type": schema.StringAttribute{Computed: true, Description: "Monitor type"},
-			"config": schema.StringAttribute{
-				Computed:    true,
-				CustomType:  jsontypes.NormalizedType{},
-				Description: "Monitor configuration",
-			},
-		},
+		Attributes: lookupAttributes(monitorItemAttributes(), "name"),
 	}
 }
 
@@ -78,10 +66,7 @@ func (d *MonitorDataSource) Read(ctx context.Context, req datasource.ReadRequest
 			resp.Diagnostics.AddError("lookup by id failed", err.Error())
 			return
 		}
-		data.ID = types.StringValue(m.ID)
-		data.Name = types.StringValue(m.Name)
-		data.Type = types.StringValue(string(m.Type))
-		data.Config = jsontypes.NewNormalizedValue(m.Config)
+		data.monitorItemModel = monitorItemFromAPI(m)
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 		return
 	}
@@ -91,16 +76,16 @@ func (d *MonitorDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		resp.Diagnostics.AddError("list monitors failed", err.Error())
 		return
 	}
-	name := strings.ToLower(data.Name.ValueString())
-	for _, m := range list.Items {
-		if strings.ToLower(m.Name) == name || (name != "" && strings.Contains(strings.ToLower(m.Name), name)) {
-			data.ID = types.StringValue(m.ID)
-			data.Name = types.StringValue(m.Name)
-			data.Type = types.StringValue(string(m.Type))
-			data.Config = jsontypes.NewNormalizedValue(m.Config)
-			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-			return
-		}
+	match, err := newNameMatcher(data.Match.ValueString(), data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Invalid Match Pattern", err.Error())
+		return
+	}
+	m, err := findUnique(list.Items, func(x *peekaping.Monitor) (string, string) { return x.Name, x.ID }, match, "monitor", data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("lookup failed", err.Error())
+		return
 	}
-	resp.Diagnostics.AddError("not found", "no monitor matched the given criteria")
+	data.monitorItemModel = monitorItemFromAPI(m)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -0,0 +1,148 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
+)
+
+var _ datasource.DataSource = &MonitorHeartbeatsDataSource{}
+
+const (
+	defaultHeartbeatWindow = "24h"
+	defaultHeartbeatLimit  = 100
+)
+
+type MonitorHeartbeatsDataSource struct {
+	client *peekaping.Client
+}
+
+func NewMonitorHeartbeatsDataSource() datasource.DataSource { return &MonitorHeartbeatsDataSource{} }
+
+type monitorHeartbeatsDataSourceModel struct {
+	ID         types.String     `tfsdk:"id"`
+	MonitorID  types.String     `tfsdk:"monitor_id"`
+	Window     types.String     `tfsdk:"window"`
+	Limit      types.Int64      `tfsdk:"limit"`
+	Heartbeats []heartbeatModel `tfsdk:"heartbeats"`
+}
+
+type heartbeatModel struct {
+	Status types.Int64  `tfsdk:"status"`
+	Ping   types.Int64  `tfsdk:"ping"`
+	Msg    types.String `tfsdk:"msg"`
+	Time   types.String `tfsdk:"time"`
+}
+
+func (d *MonitorHeartbeatsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitor_heartbeats"
+}
+
+func (d *MonitorHeartbeatsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Recent check results (heartbeats) of a monitor, newest first.",
+		Attributes: map[string]schema.Attribute{
+			"id":         schema.StringAttribute{Computed: true, Description: "Same as monitor_id"},
+			"monitor_id": schema.StringAttribute{Required: true, Description: "Monitor ID"},
+			"window": schema.StringAttribute{
+				Optional:    true,
+				Description: "How far back to look, as a Go duration (e.g. 1h, 24h, 168h). Defaults to 24h. Use 0 for no time limit.",
+			},
+			"limit": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of heartbeats to return. Defaults to 100.",
+			},
+			"heartbeats": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Heartbeats within the window, newest first",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"status": schema.Int64Attribute{Computed: true, Description: "Status (0=down, 1=up, 2=pending, 3=maintenance)"},
+						"ping":   schema.Int64Attribute{Computed: true, Description: "Response time in milliseconds"},
+						"msg":    schema.StringAttribute{Computed: true, Description: "Check result message"},
+						"time":   schema.StringAttribute{Computed: true, Description: "Time of the check"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *MonitorHeartbeatsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*peekaping.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *peekaping.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *MonitorHeartbeatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data monitorHeartbeatsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	window := defaultHeartbeatWindow
+	if !data.Window.IsNull() {
+		window = data.Window.ValueString()
+	}
+	dur, err := time.ParseDuration(window)
+	if err != nil || dur < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("window"),
+			"Invalid Window",
+			fmt.Sprintf("window must be a non-negative duration such as 1h or 24h, got: %q", window),
+		)
+		return
+	}
+
+	limit := int64(defaultHeartbeatLimit)
+	if !data.Limit.IsNull() {
+		limit = data.Limit.ValueInt64()
+	}
+	if limit < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("limit"), "Invalid Limit", "limit must be at least 1")
+		return
+	}
+
+	var since time.Time
+	if dur > 0 {
+		since = time.Now().Add(-dur)
+	}
+
+	list, err := d.client.ListHeartbeats(ctx, data.MonitorID.ValueString(), since, int(limit))
+	if err != nil {
+		resp.Diagnostics.AddError("list heartbeats failed", err.Error())
+		return
+	}
+
+	data.ID = data.MonitorID
+	data.Heartbeats = make([]heartbeatModel, 0, len(list.Items))
+	for _, hb := range list.Items {
+		data.Heartbeats = append(data.Heartbeats, heartbeatModel{
+			Status: types.Int64Value(int64(hb.Status)),
+			Ping:   types.Int64Value(hb.Ping),
+			Msg:    types.StringValue(hb.Msg),
+			Time:   types.StringValue(hb.Time),
+		})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
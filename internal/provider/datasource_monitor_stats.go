@@ -0,0 +1,184 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
+)
+
+var _ datasource.DataSource = &MonitorStatsDataSource{}
+
+const (
+	// defaultStatsHeartbeatLimit is how many beats response times are computed from
+	// unless heartbeat_limit is set. Beats are fetched 100 per request.
+	defaultStatsHeartbeatLimit = 500
+	// maxStatsHeartbeatLimit bounds heartbeat_limit.
+	maxStatsHeartbeatLimit = 5000
+)
+
+type MonitorStatsDataSource struct {
+	client *peekaping.Client
+}
+
+func NewMonitorStatsDataSource() datasource.DataSource { return &MonitorStatsDataSource{} }
+
+type monitorStatsDataSourceModel struct {
+	ID                types.String  `tfsdk:"id"`
+	MonitorID         types.String  `tfsdk:"monitor_id"`
+	HeartbeatLimit    types.Int64   `tfsdk:"heartbeat_limit"`
+	Uptime24h         types.Float64 `tfsdk:"uptime_24h"`
+	Uptime7d          types.Float64 `tfsdk:"uptime_7d"`
+	Uptime30d         types.Float64 `tfsdk:"uptime_30d"`
+	AvgResponseTime   types.Float64 `tfsdk:"avg_response_time"`
+	P95ResponseTime   types.Float64 `tfsdk:"p95_response_time"`
+	CertDaysRemaining types.Int64   `tfsdk:"cert_days_remaining"`
+}
+
+func (d *MonitorStatsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitor_stats"
+}
+
+func (d *MonitorStatsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Uptime and response-time statistics of a monitor.",
+		Attributes: map[string]schema.Attribute{
+			"id":         schema.StringAttribute{Computed: true, Description: "Same as monitor_id"},
+			"monitor_id": schema.StringAttribute{Required: true, Description: "Monitor ID"},
+			"heartbeat_limit": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Maximum number of recent heartbeats response times are computed from (1-%d). Defaults to %d.", maxStatsHeartbeatLimit, defaultStatsHeartbeatLimit),
+			},
+			"uptime_24h": schema.Float64Attribute{Computed: true, Description: "Uptime percentage over the last 24 hours"},
+			"uptime_7d":  schema.Float64Attribute{Computed: true, Description: "Uptime percentage over the last 7 days"},
+			"uptime_30d": schema.Float64Attribute{Computed: true, Description: "Uptime percentage over the last 30 days"},
+			"avg_response_time": schema.Float64Attribute{
+				Computed:    true,
+				Description: "Average response time in milliseconds of successful checks among the last heartbeat_limit heartbeats of the past 24 hours",
+			},
+			"p95_response_time": schema.Float64Attribute{
+				Computed:    true,
+				Description: "95th percentile response time in milliseconds of successful checks among the last heartbeat_limit heartbeats of the past 24 hours",
+			},
+			"cert_days_remaining": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Days until the TLS certificate expires (HTTP monitors only)",
+			},
+		},
+	}
+}
+
+func (d *MonitorStatsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*peekaping.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *peekaping.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *MonitorStatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data monitorStatsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	monitorID := data.MonitorID.ValueString()
+
+	limit := int64(defaultStatsHeartbeatLimit)
+	if !data.HeartbeatLimit.IsNull() {
+		limit = data.HeartbeatLimit.ValueInt64()
+	}
+	if limit < 1 || limit > maxStatsHeartbeatLimit {
+		resp.Diagnostics.AddAttributeError(path.Root("heartbeat_limit"), "Invalid Heartbeat Limit", fmt.Sprintf("heartbeat_limit must be between 1 and %d", maxStatsHeartbeatLimit))
+		return
+	}
+
+	m, err := d.client.GetMonitor(ctx, monitorID)
+	if err != nil {
+		resp.Diagnostics.AddError("read monitor failed", err.Error())
+		return
+	}
+
+	uptime, err := d.client.GetMonitorUptimeStats(ctx, monitorID)
+	if err != nil {
+		resp.Diagnostics.AddError("read uptime stats failed", err.Error())
+		return
+	}
+	data.Uptime24h = types.Float64Value(uptime.Uptime24h)
+	data.Uptime7d = types.Float64Value(uptime.Uptime7d)
+	data.Uptime30d = types.Float64Value(uptime.Uptime30d)
+
+	beats, err := d.client.ListHeartbeats(ctx, monitorID, time.Now().Add(-24*time.Hour), int(limit))
+	if err != nil {
+		resp.Diagnostics.AddError("list heartbeats failed", err.Error())
+		return
+	}
+	var pings []int64
+	for _, hb := range beats.Items {
+		if hb.Status == peekaping.MonitorStatusUp {
+			pings = append(pings, hb.Ping)
+		}
+	}
+	if avg, p95, ok := responseTimeStats(pings); ok {
+		data.AvgResponseTime = types.Float64Value(avg)
+		data.P95ResponseTime = types.Float64Value(p95)
+	} else {
+		data.AvgResponseTime = types.Float64Null()
+		data.P95ResponseTime = types.Float64Null()
+	}
+
+	data.CertDaysRemaining = types.Int64Null()
+	if strings.HasPrefix(string(m.Type), "http") {
+		tls, err := d.client.GetMonitorTLSInfo(ctx, monitorID)
+		if err != nil {
+			resp.Diagnostics.AddError("read TLS info failed", err.Error())
+			return
+		}
+		// Plain HTTP targets and monitors that haven't run yet have no certificate
+		if tls != nil && (tls.ValidTo != "" || tls.DaysRemaining != 0) {
+			data.CertDaysRemaining = types.Int64Value(tls.DaysRemaining)
+		}
+	}
+
+	data.ID = data.MonitorID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// responseTimeStats returns the mean and nearest-rank 95th percentile of the given
+// response times. ok is false when there are no samples.
+func responseTimeStats(pings []int64) (avg, p95 float64, ok bool) {
+	if len(pings) == 0 {
+		return 0, 0, false
+	}
+	sorted := slices.Clone(pings)
+	slices.Sort(sorted)
+
+	var sum int64
+	for _, p := range sorted {
+		sum += p
+	}
+	avg = float64(sum) / float64(len(sorted))
+
+	rank := int(math.Ceil(0.95 * float64(len(sorted))))
+	p95 = float64(sorted[rank-1])
+	return avg, p95, true
+}
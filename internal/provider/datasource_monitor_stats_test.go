@@ -0,0 +1,31 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import "testing"
+
+// TestResponseTimeStats tests mean and nearest-rank p95 computation.
+func TestResponseTimeStats(t *testing.T) {
+	tests := []struct {
+		name    string
+		pings   []int64
+		wantAvg float64
+		wantP95 float64
+		wantOK  bool
+	}{
+		{"No samples", nil, 0, 0, false},
+		{"Single sample", []int64{42}, 42, 42, true},
+		{"Unsorted", []int64{30, 10, 20}, 20, 30, true},
+		{"Twenty samples", []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 200}, 19.5, 19, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			avg, p95, ok := responseTimeStats(tt.pings)
+			if ok != tt.wantOK || avg != tt.wantAvg || p95 != tt.wantP95 {
+				t.Errorf("responseTimeStats(%v) = (%v, %v, %v), want (%v, %v, %v)", tt.pings, avg, p95, ok, tt.wantAvg, tt.wantP95, tt.wantOK)
+			}
+		})
+	}
+}
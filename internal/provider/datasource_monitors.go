@@ -0,0 +1,244 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
+)
+
+var _ datasource.DataSource = &MonitorsDataSource{}
+
+type MonitorsDataSource struct {
+	client *peekaping.Client
+}
+
+func NewMonitorsDataSource() datasource.DataSource { return &MonitorsDataSource{} }
+
+type monitorsDataSourceModel struct {
+	monitorFilterModel
+	Monitors []monitorItemModel `tfsdk:"monitors"`
+}
+
+// monitorFilterModel holds the filters shared by the monitors data source and list resource.
+type monitorFilterModel struct {
+	NameRegex types.String `tfsdk:"name_regex"`
+	Type      types.String `tfsdk:"type"`
+	Tag       types.String `tfsdk:"tag"`
+	TagValue  types.String `tfsdk:"tag_value"`
+	Active    types.Bool   `tfsdk:"active"`
+	Status    types.Int64  `tfsdk:"status"`
+}
+
+// monitorItemModel is a monitor as exported by data sources.
+type monitorItemModel struct {
+	ID              types.String         `tfsdk:"id"`
+	Name            types.String         `tfsdk:"name"`
+	Type            types.String         `tfsdk:"type"`
+	Config          jsontypes.Normalized `tfsdk:"config"`
+	Interval        types.Int64          `tfsdk:"interval"`
+	Active          types.Bool           `tfsdk:"active"`
+	Timeout         types.Int64          `tfsdk:"timeout"`
+	MaxRetries      types.Int64          `tfsdk:"max_retries"`
+	RetryInterval   types.Int64          `tfsdk:"retry_interval"`
+	ResendInterval  types.Int64          `tfsdk:"resend_interval"`
+	ProxyID         types.String         `tfsdk:"proxy_id"`
+	PushToken       types.String         `tfsdk:"push_token"`
+	NotificationIDs types.List           `tfsdk:"notification_ids"`
+	Tags            types.Set            `tfsdk:"tags"`
+	Status          types.Int64          `tfsdk:"status"`
+	CreatedAt       types.String         `tfsdk:"created_at"`
+	UpdatedAt       types.String         `tfsdk:"updated_at"`
+}
+
+// monitorItemAttributes returns the computed schema of monitorItemModel.
+func monitorItemAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id":   schema.StringAttribute{Computed: true, Description: "Monitor ID"},
+		"name": schema.StringAttribute{Computed: true, Description: "Monitor name"},
+		"type": schema.StringAttribute{Computed: true, Description: "Monitor type"},
+		"config": schema.StringAttribute{
+			Computed:    true,
+			CustomType:  jsontypes.NormalizedType{},
+			Description: "Monitor configuration",
+		},
+		"interval":         schema.Int64Attribute{Computed: true, Description: "Check interval in seconds"},
+		"active":           schema.BoolAttribute{Computed: true, Description: "Whether the monitor is active"},
+		"timeout":          schema.Int64Attribute{Computed: true, Description: "Check timeout in seconds"},
+		"max_retries":      schema.Int64Attribute{Computed: true, Description: "Maximum retries before marking as down"},
+		"retry_interval":   schema.Int64Attribute{Computed: true, Description: "Retry interval in seconds"},
+		"resend_interval":  schema.Int64Attribute{Computed: true, Description: "Resend notification if down X times consecutively"},
+		"proxy_id":         schema.StringAttribute{Computed: true, Description: "Proxy ID"},
+		"push_token":       schema.StringAttribute{Computed: true, Description: "Push token for push monitors"},
+		"notification_ids": schema.ListAttribute{Computed: true, ElementType: types.StringType, Description: "Notification channel IDs"},
+		"tags": schema.SetNestedAttribute{
+			Computed:    true,
+			Description: "Tags assigned to the monitor with their per-monitor values",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"tag_id": schema.StringAttribute{Computed: true, Description: "Tag ID"},
+					"value":  schema.StringAttribute{Computed: true, Description: "Per-monitor tag value"},
+				},
+			},
+		},
+		"status":     schema.Int64Attribute{Computed: true, Description: "Current status (0=down, 1=up, 2=pending, 3=maintenance)"},
+		"created_at": schema.StringAttribute{Computed: true, Description: "Creation timestamp"},
+		"updated_at": schema.StringAttribute{Computed: true, Description: "Last update timestamp"},
+	}
+}
+
+func monitorItemFromAPI(m *peekaping.Monitor) monitorItemModel {
+	return monitorItemModel{
+		ID:              types.StringValue(m.ID),
+		Name:            types.StringValue(m.Name),
+		Type:            types.StringValue(string(m.Type)),
+		Config:          optionalJSON(m.Config),
+		Interval:        types.Int64Value(m.Interval),
+		Active:          types.BoolValue(m.Active),
+		Timeout:         types.Int64Value(m.Timeout),
+		MaxRetries:      types.Int64Value(m.MaxRetries),
+		RetryInterval:   types.Int64Value(m.RetryInterval),
+		ResendInterval:  types.Int64Value(m.ResendInterval),
+		ProxyID:         optionalString(m.ProxyID),
+		PushToken:       optionalString(m.PushToken),
+		NotificationIDs: toStringList(m.NotificationIDs),
+		Tags:            monitorTagsToSet(monitorTagsOf(m)),
+		Status:          types.Int64Value(int64(m.Status)),
+		CreatedAt:       optionalString(m.CreatedAt),
+		UpdatedAt:       optionalString(m.UpdatedAt),
+	}
+}
+
+// optionalJSON maps an empty API config to null, since "" is not valid JSON.
+func optionalJSON(s string) jsontypes.Normalized {
+	if s == "" {
+		return jsontypes.NewNormalizedNull()
+	}
+	return jsontypes.NewNormalizedValue(s)
+}
+
+// optionalString maps an empty API string to null.
+func optionalString(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+func (d *MonitorsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitors"
+}
+
+func (d *MonitorsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists monitors, optionally filtered.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{Optional: true, Description: "Only return monitors whose name matches this regular expression"},
+			"type":       schema.StringAttribute{Optional: true, Description: "Only return monitors of this type"},
+			"tag":        schema.StringAttribute{Optional: true, Description: "Only return monitors carrying this tag, given by ID or name"},
+			"tag_value":  schema.StringAttribute{Optional: true, Description: "Only return monitors carrying a tag with this per-monitor value, or with tag, carrying that tag with this value"},
+			"active":     schema.BoolAttribute{Optional: true, Description: "Only return active (true) or paused (false) monitors"},
+			"status":     schema.Int64Attribute{Optional: true, Description: "Only return monitors with this status (0=down, 1=up, 2=pending, 3=maintenance)"},
+			"monitors": schema.ListNestedAttribute{
+				Computed:     true,
+				Description:  "Matching monitors",
+				NestedObject: schema.NestedAttributeObject{Attributes: monitorItemAttributes()},
+			},
+		},
+	}
+}
+
+func (d *MonitorsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*peekaping.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *peekaping.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *MonitorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data monitorsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, diags := data.find(ctx, d.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Monitors = make([]monitorItemModel, 0, len(items))
+	for i := range items {
+		data.Monitors = append(data.Monitors, monitorItemFromAPI(&items[i]))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// find returns the monitors matching f.
+func (f *monitorFilterModel) find(ctx context.Context, client *peekaping.Client) ([]peekaping.Monitor, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	nameRe := compileFilterRegex(f.NameRegex, "name_regex", &diags)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// Resolve the tag filter to tag IDs so it accepts both IDs and names
+	var tagIDs []string
+	if !f.Tag.IsNull() {
+		tags, err := client.ListTags(ctx)
+		if err != nil {
+			diags.AddError("list tags failed", err.Error())
+			return nil, diags
+		}
+		tag := f.Tag.ValueString()
+		tagIDs = []string{tag}
+		for _, t := range tags.Items {
+			if t.Name == tag {
+				tagIDs = append(tagIDs, t.ID)
+			}
+		}
+	}
+
+	list, err := client.ListMonitors(ctx)
+	if err != nil {
+		diags.AddError("list monitors failed", err.Error())
+		return nil, diags
+	}
+
+	var out []peekaping.Monitor
+	for i := range list.Items {
+		m := &list.Items[i]
+		if !matchesRegex(nameRe, m.Name) ||
+			!matchesString(f.Type, string(m.Type)) ||
+			!matchesBool(f.Active, m.Active) ||
+			(!f.Status.IsNull() && f.Status.ValueInt64() != int64(m.Status)) {
+			continue
+		}
+		if (tagIDs != nil || !f.TagValue.IsNull()) && !slices.ContainsFunc(monitorTagsOf(m), func(t peekaping.MonitorTag) bool {
+			return (tagIDs == nil || slices.Contains(tagIDs, t.TagID)) && matchesString(f.TagValue, t.Value)
+		}) {
+			continue
+		}
+		out = append(out, *m)
+	}
+	return out, diags
+}
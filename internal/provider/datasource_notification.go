@@ -6,11 +6,10 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
@@ -25,12 +24,8 @@ type NotificationDataSource struct {
 func NewNotificationDataSource() datasource.DataSource { return &NotificationDataSource{} }
 
 type notificationDataSourceModel struct {
-	ID        types.String         `tfsdk:"id"`
-	Name      types.String         `tfsdk:"name"`
-	Type      types.String         `tfsdk:"type"`
-	Config    jsontypes.Normalized `tfsdk:"config"`
-	Active    types.Bool           `tfsdk:"active"`
-	IsDefault types.Bool           `tfsdk:"is_default"`
+	notificationItemModel
+	Match types.String `tfsdk:"match"`
 }
 
 func (d *NotificationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -39,18 +34,7 @@ func (d *NotificationDataSource) Metadata(_ context.Context, req datasource.Meta
 
 func (d *NotificationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"id":   schema.StringAttribute{Optional: true, Description: "Notification ID"},
-			"name": schema.StringAttribute{Optional: true, Description: "Notification name"},
-			"type": schema.StringAttribute{Computed: true, Description: "Notification type"},
-			"config": schema.StringAttribute{
-				Computed:    true,
-				CustomType:  jsontypes.NormalizedType{},
-				Description: "Notification configuration",
-			},
-			"active":     schema.BoolAttribute{Computed: true, Description: "Whether the notification channel is active"},
-			"is_default": schema.BoolAttribute{Computed: true, Description: "Whether this is the default notification channel"},
-		},
+		Attributes: lookupAttributes(notificationItemAttributes(), "name"),
 	}
 }
 
@@ -82,12 +66,7 @@ func (d *NotificationDataSource) Read(ctx context.Context, req datasource.ReadRe
 			resp.Diagnostics.AddError("lookup by id failed", err.Error())
 			return
 		}
-		data.ID = types.StringValue(n.ID)
-		data.Name = types.StringValue(n.Name)
-		data.Type = types.StringValue(n.Type)
-		data.Config = jsontypes.NewNormalizedValue(n.Config)
-		data.Active = types.BoolValue(n.Active)
-		data.IsDefault = types.BoolValue(n.IsDefault)
+		data.notificationItemModel = notificationItemFromAPI(n)
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 		return
 	}
@@ -97,18 +76,16 @@ func (d *NotificationDataSource) Read(ctx context.Context, req datasource.ReadRe
 		resp.Diagnostics.AddError("list notifications failed", err.Error())
 		return
 	}
-	name := strings.ToLower(data.Name.ValueString())
-	for _, n := range list.Items {
-		if strings.ToLower(n.Name) == name || (name != "" && strings.Contains(strings.ToLower(n.Name), name)) {
-			data.ID = types.StringValue(n.ID)
-			data.Name = types.StringValue(n.Name)
-			data.Type = types.StringValue(n.Type)
-			data.Config = jsontypes.NewNormalizedValue(n.Config)
-			data.Active = types.BoolValue(n.Active)
-			data.IsDefault = types.BoolValue(n.IsDefault)
-			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-			return
-		}
+	match, err := newNameMatcher(data.Match.ValueString(), data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Invalid Match Pattern", err.Error())
+		return
+	}
+	n, err := findUnique(list.Items, func(x *peekaping.Notification) (string, string) { return x.Name, x.ID }, match, "notification", data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("lookup failed", err.Error())
+		return
 	}
-	resp.Diagnostics.AddError("not found", "no notification matched the given criteria")
+	data.notificationItemModel = notificationItemFromAPI(n)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
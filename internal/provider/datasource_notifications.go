@@ -0,0 +1,160 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
+)
+
+var _ datasource.DataSource = &NotificationsDataSource{}
+
+type NotificationsDataSource struct {
+	client *peekaping.Client
+}
+
+func NewNotificationsDataSource() datasource.DataSource { return &NotificationsDataSource{} }
+
+type notificationsDataSourceModel struct {
+	notificationFilterModel
+	Notifications []notificationItemModel `tfsdk:"notifications"`
+}
+
+// notificationFilterModel holds the filters shared by the notifications data source and list resource.
+type notificationFilterModel struct {
+	NameRegex types.String `tfsdk:"name_regex"`
+	Type      types.String `tfsdk:"type"`
+	Active    types.Bool   `tfsdk:"active"`
+}
+
+// notificationItemModel is a notification channel as exported by data sources.
+type notificationItemModel struct {
+	ID        types.String         `tfsdk:"id"`
+	Name      types.String         `tfsdk:"name"`
+	Type      types.String         `tfsdk:"type"`
+	Config    jsontypes.Normalized `tfsdk:"config"`
+	Active    types.Bool           `tfsdk:"active"`
+	IsDefault types.Bool           `tfsdk:"is_default"`
+	CreatedAt types.String         `tfsdk:"created_at"`
+	UpdatedAt types.String         `tfsdk:"updated_at"`
+}
+
+// notificationItemAttributes returns the computed schema of notificationItemModel.
+func notificationItemAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id":   schema.StringAttribute{Computed: true, Description: "Notification ID"},
+		"name": schema.StringAttribute{Computed: true, Description: "Notification name"},
+		"type": schema.StringAttribute{Computed: true, Description: "Notification type"},
+		"config": schema.StringAttribute{
+			Computed:    true,
+			CustomType:  jsontypes.NormalizedType{},
+			Description: "Notification configuration",
+		},
+		"active":     schema.BoolAttribute{Computed: true, Description: "Whether the notification is active"},
+		"is_default": schema.BoolAttribute{Computed: true, Description: "Whether this is the default notification"},
+		"created_at": schema.StringAttribute{Computed: true, Description: "Creation timestamp"},
+		"updated_at": schema.StringAttribute{Computed: true, Description: "Last update timestamp"},
+	}
+}
+
+func notificationItemFromAPI(n *peekaping.Notification) notificationItemModel {
+	return notificationItemModel{
+		ID:        types.StringValue(n.ID),
+		Name:      types.StringValue(n.Name),
+		Type:      types.StringValue(n.Type),
+		Config:    optionalJSON(n.Config),
+		Active:    types.BoolValue(n.Active),
+		IsDefault: types.BoolValue(n.IsDefault),
+		CreatedAt: optionalString(n.CreatedAt),
+		UpdatedAt: optionalString(n.UpdatedAt),
+	}
+}
+
+func (d *NotificationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notifications"
+}
+
+func (d *NotificationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists notification channels, optionally filtered.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{Optional: true, Description: "Only return notifications whose name matches this regular expression"},
+			"type":       schema.StringAttribute{Optional: true, Description: "Only return notifications of this type"},
+			"active":     schema.BoolAttribute{Optional: true, Description: "Only return active (true) or inactive (false) notifications"},
+			"notifications": schema.ListNestedAttribute{
+				Computed:     true,
+				Description:  "Matching notification channels",
+				NestedObject: schema.NestedAttributeObject{Attributes: notificationItemAttributes()},
+			},
+		},
+	}
+}
+
+func (d *NotificationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*peekaping.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *peekaping.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *NotificationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data notificationsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, diags := data.find(ctx, d.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Notifications = make([]notificationItemModel, 0, len(items))
+	for i := range items {
+		data.Notifications = append(data.Notifications, notificationItemFromAPI(&items[i]))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// find returns the notifications matching f.
+func (f *notificationFilterModel) find(ctx context.Context, client *peekaping.Client) ([]peekaping.Notification, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	nameRe := compileFilterRegex(f.NameRegex, "name_regex", &diags)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	list, err := client.ListNotifications(ctx)
+	if err != nil {
+		diags.AddError("list notifications failed", err.Error())
+		return nil, diags
+	}
+
+	var out []peekaping.Notification
+	for i := range list.Items {
+		n := &list.Items[i]
+		if !matchesRegex(nameRe, n.Name) || !matchesString(f.Type, n.Type) || !matchesBool(f.Active, n.Active) {
+			continue
+		}
+		out = append(out, *n)
+	}
+	return out, diags
+}
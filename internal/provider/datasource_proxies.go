@@ -0,0 +1,155 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
+)
+
+var _ datasource.DataSource = &ProxiesDataSource{}
+
+type ProxiesDataSource struct {
+	client *peekaping.Client
+}
+
+func NewProxiesDataSource() datasource.DataSource { return &ProxiesDataSource{} }
+
+type proxiesDataSourceModel struct {
+	proxyFilterModel
+	Proxies []proxyItemModel `tfsdk:"proxies"`
+}
+
+// proxyFilterModel holds the filters shared by the proxies data source and list resource.
+type proxyFilterModel struct {
+	HostRegex types.String `tfsdk:"host_regex"`
+	Protocol  types.String `tfsdk:"protocol"`
+	Auth      types.Bool   `tfsdk:"auth"`
+}
+
+// proxyItemModel is a proxy as exported by data sources. The password is never exported.
+type proxyItemModel struct {
+	ID        types.String `tfsdk:"id"`
+	Host      types.String `tfsdk:"host"`
+	Port      types.Int64  `tfsdk:"port"`
+	Protocol  types.String `tfsdk:"protocol"`
+	Auth      types.Bool   `tfsdk:"auth"`
+	Username  types.String `tfsdk:"username"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// proxyItemAttributes returns the computed schema of proxyItemModel.
+func proxyItemAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id":         schema.StringAttribute{Computed: true, Description: "Proxy ID"},
+		"host":       schema.StringAttribute{Computed: true, Description: "Proxy host"},
+		"port":       schema.Int64Attribute{Computed: true, Description: "Proxy port"},
+		"protocol":   schema.StringAttribute{Computed: true, Description: "Proxy protocol"},
+		"auth":       schema.BoolAttribute{Computed: true, Description: "Whether the proxy requires authentication"},
+		"username":   schema.StringAttribute{Computed: true, Description: "Proxy username"},
+		"created_at": schema.StringAttribute{Computed: true, Description: "Creation timestamp"},
+		"updated_at": schema.StringAttribute{Computed: true, Description: "Last update timestamp"},
+	}
+}
+
+func proxyItemFromAPI(p *peekaping.Proxy) proxyItemModel {
+	return proxyItemModel{
+		ID:        types.StringValue(p.ID),
+		Host:      types.StringValue(p.Host),
+		Port:      types.Int64Value(int64(p.Port)),
+		Protocol:  types.StringValue(string(p.Protocol)),
+		Auth:      types.BoolValue(p.Auth),
+		Username:  optionalString(p.Username),
+		CreatedAt: optionalString(p.CreatedDate),
+		UpdatedAt: optionalString(p.UpdatedAt),
+	}
+}
+
+func (d *ProxiesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_proxies"
+}
+
+func (d *ProxiesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists proxies, optionally filtered.",
+		Attributes: map[string]schema.Attribute{
+			"host_regex": schema.StringAttribute{Optional: true, Description: "Only return proxies whose host matches this regular expression"},
+			"protocol":   schema.StringAttribute{Optional: true, Description: "Only return proxies using this protocol"},
+			"auth":       schema.BoolAttribute{Optional: true, Description: "Only return proxies that do (true) or don't (false) require authentication"},
+			"proxies": schema.ListNestedAttribute{
+				Computed:     true,
+				Description:  "Matching proxies",
+				NestedObject: schema.NestedAttributeObject{Attributes: proxyItemAttributes()},
+			},
+		},
+	}
+}
+
+func (d *ProxiesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*peekaping.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *peekaping.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *ProxiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data proxiesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, diags := data.find(ctx, d.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Proxies = make([]proxyItemModel, 0, len(items))
+	for i := range items {
+		data.Proxies = append(data.Proxies, proxyItemFromAPI(&items[i]))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// find returns the proxies matching f.
+func (f *proxyFilterModel) find(ctx context.Context, client *peekaping.Client) ([]peekaping.Proxy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	hostRe := compileFilterRegex(f.HostRegex, "host_regex", &diags)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	list, err := client.ListProxies(ctx)
+	if err != nil {
+		diags.AddError("list proxies failed", err.Error())
+		return nil, diags
+	}
+
+	var out []peekaping.Proxy
+	for i := range list.Items {
+		p := &list.Items[i]
+		if !matchesRegex(hostRe, p.Host) || !matchesString(f.Protocol, string(p.Protocol)) || !matchesBool(f.Auth, p.Auth) {
+			continue
+		}
+		out = append(out, *p)
+	}
+	return out, diags
+}
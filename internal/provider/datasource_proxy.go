@@ -6,10 +6,10 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
@@ -24,12 +24,8 @@ type ProxyDataSource struct {
 func NewProxyDataSource() datasource.DataSource { return &ProxyDataSource{} }
 
 type proxyDataSourceModel struct {
-	ID       types.String `tfsdk:"id"`
-	Host     types.String `tfsdk:"host"`
-	Port     types.Int64  `tfsdk:"port"`
-	Protocol types.String `tfsdk:"protocol"`
-	Auth     types.Bool   `tfsdk:"auth"`
-	Username types.String `tfsdk:"username"`
+	proxyItemModel
+	Match types.String `tfsdk:"match"`
 }
 
 func (d *ProxyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -38,14 +34,7 @@ func (d *ProxyDataSource) Metadata(_ context.Context, req datasource.MetadataReq
 
 func (d *ProxyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"id":       schema.StringAttribute{Optional: true, Description: "Proxy ID"},
-			"host":     schema.StringAttribute{Optional: true, Description: "Proxy host"},
-			"port":     schema.Int64Attribute{Computed: true, Description: "Proxy port"},
-			"protocol": schema.StringAttribute{Computed: true, Description: "Proxy protocol"},
-			"auth":     schema.BoolAttribute{Computed: true, Description: "Whether authentication is required"},
-			"username": schema.StringAttribute{Computed: true, Description: "Username for authentication"},
-		},
+		Attributes: lookupAttributes(proxyItemAttributes(), "host"),
 	}
 }
 
@@ -77,16 +66,7 @@ func (d *ProxyDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 			resp.Diagnostics.AddError("lookup by id failed", err.Error())
 			return
 		}
-		data.ID = types.StringValue(p.ID)
-		data.Host = types.StringValue(p.Host)
-		data.Port = types.Int64Value(int64(p.Port))
-		data.Protocol = types.StringValue(string(p.Protocol))
-		data.Auth = types.BoolValue(p.Auth)
-		if p.Username != "" {
-			data.Username = types.StringValue(p.Username)
-		} else {
-			data.Username = types.StringNull()
-		}
+		data.proxyItemModel = proxyItemFromAPI(p)
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 		return
 	}
@@ -96,22 +76,16 @@ func (d *ProxyDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		resp.Diagnostics.AddError("list proxies failed", err.Error())
 		return
 	}
-	host := strings.ToLower(data.Host.ValueString())
-	for _, p := range list.Items {
-		if strings.ToLower(p.Host) == host || (host != "" && strings.Contains(strings.ToLower(p.Host), host)) {
-			data.ID = types.StringValue(p.ID)
-			data.Host = types.StringValue(p.Host)
-			data.Port = types.Int64Value(int64(p.Port))
-			data.Protocol = types.StringValue(string(p.Protocol))
-			data.Auth = types.BoolValue(p.Auth)
-			if p.Username != "" {
-				data.Username = types.StringValue(p.Username)
-			} else {
-				data.Username = types.StringNull()
-			}
-			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-			return
-		}
+	match, err := newNameMatcher(data.Match.ValueString(), data.Host.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("host"), "Invalid Match Pattern", err.Error())
+		return
+	}
+	p, err := findUnique(list.Items, func(x *peekaping.Proxy) (string, string) { return x.Host, x.ID }, match, "proxy", data.Host.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("lookup failed", err.Error())
+		return
 	}
-	resp.Diagnostics.AddError("not found", "no proxy matched the given criteria")
+	data.proxyItemModel = proxyItemFromAPI(p)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
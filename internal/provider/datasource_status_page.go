@@ -6,10 +6,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
@@ -26,6 +27,7 @@ func NewStatusPageDataSource() datasource.DataSource { return &StatusPageDataSou
 type statusPageDataSourceModel struct {
 	ID                    types.String   `tfsdk:"id"`
 	Title                 types.String   `tfsdk:"title"`
+	Match                 types.String   `tfsdk:"match"`
 	Description           types.String   `tfsdk:"description"`
 	Slug                  types.String   `tfsdk:"slug"`
 	Domains               []types.String `tfsdk:"domains"`
@@ -49,8 +51,15 @@ func (d *StatusPageDataSource) Metadata(_ context.Context, req datasource.Metada
 func (d *StatusPageDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			"id":                      schema.StringAttribute{Optional: true, Description: "Status page ID"},
-			"title":                   schema.StringAttribute{Optional: true, Description: "Status page title"},
+			"id":    schema.StringAttribute{Optional: true, Description: "Status page ID"},
+			"title": schema.StringAttribute{Optional: true, Description: "Status page title"},
+			"match": schema.StringAttribute{
+				Optional:    true,
+				Description: "How title is matched: exact (default), case_insensitive, prefix or regex. Exactly one object must match. Slug lookups are always exact.",
+				Validators: []validator.String{
+					matchModeValidator{},
+				},
+			},
 			"description":             schema.StringAttribute{Computed: true, Description: "Status page description"},
 			"slug":                    schema.StringAttribute{Optional: true, Description: "Status page slug"},
 			"domains":                 schema.ListAttribute{Computed: true, ElementType: types.StringType, Description: "List of custom domains"},
@@ -162,71 +171,78 @@ func (d *StatusPageDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		resp.Diagnostics.AddError("list status pages failed", err.Error())
 		return
 	}
-	title := strings.ToLower(data.Title.ValueString())
-	slug := strings.ToLower(data.Slug.ValueString())
-	for _, sp := range list.Items {
-		if (title != "" && strings.ToLower(sp.Title) == title) ||
-			(slug != "" && strings.ToLower(sp.Slug) == slug) ||
-			(title != "" && strings.Contains(strings.ToLower(sp.Title), title)) {
-			data.ID = types.StringValue(sp.ID)
-			data.Title = types.StringValue(sp.Title)
-			if sp.Description != "" {
-				data.Description = types.StringValue(sp.Description)
-			} else {
-				data.Description = types.StringNull()
-			}
-			if sp.Slug != "" {
-				data.Slug = types.StringValue(sp.Slug)
-			} else {
-				data.Slug = types.StringNull()
-			}
-			if len(sp.Domains) > 0 {
-				domains := make([]types.String, 0, len(sp.Domains))
-				for _, domain := range sp.Domains {
-					domains = append(domains, types.StringValue(domain))
-				}
-				data.Domains = domains
-			} else {
-				data.Domains = nil
-			}
-			data.Published = types.BoolValue(sp.Published)
-			if sp.Theme != "" {
-				data.Theme = types.StringValue(sp.Theme)
-			} else {
-				data.Theme = types.StringNull()
-			}
-			if sp.Icon != "" {
-				data.Icon = types.StringValue(sp.Icon)
-			} else {
-				data.Icon = types.StringNull()
-			}
-			if sp.FooterText != "" {
-				data.FooterText = types.StringValue(sp.FooterText)
-			} else {
-				data.FooterText = types.StringNull()
-			}
-			if sp.CustomCSS != "" {
-				data.CustomCSS = types.StringValue(sp.CustomCSS)
-			} else {
-				data.CustomCSS = types.StringNull()
-			}
-			if sp.GoogleAnalyticsTagID != "" {
-				data.GoogleAnalyticsTagID = types.StringValue(sp.GoogleAnalyticsTagID)
-			} else {
-				data.GoogleAnalyticsTagID = types.StringNull()
-			}
-			if sp.AutoRefreshInterval != 0 {
-				data.AutoRefreshInterval = types.Int64Value(int64(sp.AutoRefreshInterval))
-			} else {
-				data.AutoRefreshInterval = types.Int64Null()
-			}
-			data.SearchEngineIndex = types.BoolValue(sp.SearchEngineIndex)
-			data.ShowCertificateExpiry = types.BoolValue(sp.ShowCertificateExpiry)
-			data.ShowPoweredBy = types.BoolValue(sp.ShowPoweredBy)
-			data.ShowTags = types.BoolValue(sp.ShowTags)
-			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-			return
+	// Slugs are unique, so a slug lookup is always exact
+	key := func(x *peekaping.StatusPage) (string, string) { return x.Title, x.ID }
+	query, mode, attr := data.Title.ValueString(), data.Match.ValueString(), "title"
+	if !data.Slug.IsNull() && data.Slug.ValueString() != "" {
+		key = func(x *peekaping.StatusPage) (string, string) { return x.Slug, x.ID }
+		query, mode, attr = data.Slug.ValueString(), matchExact, "slug"
+	}
+	match, err := newNameMatcher(mode, query)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(attr), "Invalid Match Pattern", err.Error())
+		return
+	}
+	sp, err := findUnique(list.Items, key, match, "status page", query)
+	if err != nil {
+		resp.Diagnostics.AddError("lookup failed", err.Error())
+		return
+	}
+	data.ID = types.StringValue(sp.ID)
+	data.Title = types.StringValue(sp.Title)
+	if sp.Description != "" {
+		data.Description = types.StringValue(sp.Description)
+	} else {
+		data.Description = types.StringNull()
+	}
+	if sp.Slug != "" {
+		data.Slug = types.StringValue(sp.Slug)
+	} else {
+		data.Slug = types.StringNull()
+	}
+	if len(sp.Domains) > 0 {
+		domains := make([]types.String, 0, len(sp.Domains))
+		for _, domain := range sp.Domains {
+			domains = append(domains, types.StringValue(domain))
 		}
+		data.Domains = domains
+	} else {
+		data.Domains = nil
+	}
+	data.Published = types.BoolValue(sp.Published)
+	if sp.Theme != "" {
+		data.Theme = types.StringValue(sp.Theme)
+	} else {
+		data.Theme = types.StringNull()
+	}
+	if sp.Icon != "" {
+		data.Icon = types.StringValue(sp.Icon)
+	} else {
+		data.Icon = types.StringNull()
+	}
+	if sp.FooterText != "" {
+		data.FooterText = types.StringValue(sp.FooterText)
+	} else {
+		data.FooterText = types.StringNull()
+	}
+	if sp.CustomCSS != "" {
+		data.CustomCSS = types.StringValue(sp.CustomCSS)
+	} else {
+		data.CustomCSS = types.StringNull()
+	}
+	if sp.GoogleAnalyticsTagID != "" {
+		data.GoogleAnalyticsTagID = types.StringValue(sp.GoogleAnalyticsTagID)
+	} else {
+		data.GoogleAnalyticsTagID = types.StringNull()
+	}
+	if sp.AutoRefreshInterval != 0 {
+		data.AutoRefreshInterval = types.Int64Value(int64(sp.AutoRefreshInterval))
+	} else {
+		data.AutoRefreshInterval = types.Int64Null()
 	}
-	resp.Diagnostics.AddError("not found", "no status page matched the given criteria")
+	data.SearchEngineIndex = types.BoolValue(sp.SearchEngineIndex)
+	data.ShowCertificateExpiry = types.BoolValue(sp.ShowCertificateExpiry)
+	data.ShowPoweredBy = types.BoolValue(sp.ShowPoweredBy)
+	data.ShowTags = types.BoolValue(sp.ShowTags)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -0,0 +1,193 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
+)
+
+var _ datasource.DataSource = &StatusPagesDataSource{}
+
+type StatusPagesDataSource struct {
+	client *peekaping.Client
+}
+
+func NewStatusPagesDataSource() datasource.DataSource { return &StatusPagesDataSource{} }
+
+type statusPagesDataSourceModel struct {
+	statusPageFilterModel
+	StatusPages []statusPageItemModel `tfsdk:"status_pages"`
+}
+
+// statusPageFilterModel holds the filters shared by the status pages data source and list resource.
+type statusPageFilterModel struct {
+	NameRegex types.String `tfsdk:"name_regex"`
+	Published types.Bool   `tfsdk:"published"`
+	MonitorID types.String `tfsdk:"monitor_id"`
+}
+
+// statusPageItemModel is a status page as exported by data sources. The password is
+// never exported.
+type statusPageItemModel struct {
+	ID                    types.String `tfsdk:"id"`
+	Title                 types.String `tfsdk:"title"`
+	Description           types.String `tfsdk:"description"`
+	Slug                  types.String `tfsdk:"slug"`
+	Domains               types.List   `tfsdk:"domains"`
+	MonitorIDs            types.List   `tfsdk:"monitor_ids"`
+	Published             types.Bool   `tfsdk:"published"`
+	Theme                 types.String `tfsdk:"theme"`
+	Icon                  types.String `tfsdk:"icon"`
+	FooterText            types.String `tfsdk:"footer_text"`
+	CustomCSS             types.String `tfsdk:"custom_css"`
+	GoogleAnalyticsTagID  types.String `tfsdk:"google_analytics_tag_id"`
+	AutoRefreshInterval   types.Int64  `tfsdk:"auto_refresh_interval"`
+	SearchEngineIndex     types.Bool   `tfsdk:"search_engine_index"`
+	ShowCertificateExpiry types.Bool   `tfsdk:"show_certificate_expiry"`
+	ShowPoweredBy         types.Bool   `tfsdk:"show_powered_by"`
+	ShowTags              types.Bool   `tfsdk:"show_tags"`
+	CreatedAt             types.String `tfsdk:"created_at"`
+	UpdatedAt             types.String `tfsdk:"updated_at"`
+}
+
+// statusPageItemAttributes returns the computed schema of statusPageItemModel.
+func statusPageItemAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id":                      schema.StringAttribute{Computed: true, Description: "Status page ID"},
+		"title":                   schema.StringAttribute{Computed: true, Description: "Status page title"},
+		"description":             schema.StringAttribute{Computed: true, Description: "Status page description"},
+		"slug":                    schema.StringAttribute{Computed: true, Description: "URL slug"},
+		"domains":                 schema.ListAttribute{Computed: true, ElementType: types.StringType, Description: "Custom domains"},
+		"monitor_ids":             schema.ListAttribute{Computed: true, ElementType: types.StringType, Description: "Monitor IDs shown on the page"},
+		"published":               schema.BoolAttribute{Computed: true, Description: "Whether the page is published"},
+		"theme":                   schema.StringAttribute{Computed: true, Description: "Theme"},
+		"icon":                    schema.StringAttribute{Computed: true, Description: "Icon"},
+		"footer_text":             schema.StringAttribute{Computed: true, Description: "Footer text"},
+		"custom_css":              schema.StringAttribute{Computed: true, Description: "Custom CSS"},
+		"google_analytics_tag_id": schema.StringAttribute{Computed: true, Description: "Google Analytics tag ID"},
+		"auto_refresh_interval":   schema.Int64Attribute{Computed: true, Description: "Auto refresh interval in seconds"},
+		"search_engine_index":     schema.BoolAttribute{Computed: true, Description: "Whether search engines may index the page"},
+		"show_certificate_expiry": schema.BoolAttribute{Computed: true, Description: "Whether certificate expiry is shown"},
+		"show_powered_by":         schema.BoolAttribute{Computed: true, Description: "Whether the powered-by footer is shown"},
+		"show_tags":               schema.BoolAttribute{Computed: true, Description: "Whether tags are shown"},
+		"created_at":              schema.StringAttribute{Computed: true, Description: "Creation timestamp"},
+		"updated_at":              schema.StringAttribute{Computed: true, Description: "Last update timestamp"},
+	}
+}
+
+func statusPageItemFromAPI(sp *peekaping.StatusPage) statusPageItemModel {
+	return statusPageItemModel{
+		ID:                    types.StringValue(sp.ID),
+		Title:                 types.StringValue(sp.Title),
+		Description:           optionalString(sp.Description),
+		Slug:                  optionalString(sp.Slug),
+		Domains:               toStringList(sp.Domains),
+		MonitorIDs:            toStringList(sp.MonitorIDs),
+		Published:             types.BoolValue(sp.Published),
+		Theme:                 optionalString(sp.Theme),
+		Icon:                  optionalString(sp.Icon),
+		FooterText:            optionalString(sp.FooterText),
+		CustomCSS:             optionalString(sp.CustomCSS),
+		GoogleAnalyticsTagID:  optionalString(sp.GoogleAnalyticsTagID),
+		AutoRefreshInterval:   types.Int64Value(int64(sp.AutoRefreshInterval)),
+		SearchEngineIndex:     types.BoolValue(sp.SearchEngineIndex),
+		ShowCertificateExpiry: types.BoolValue(sp.ShowCertificateExpiry),
+		ShowPoweredBy:         types.BoolValue(sp.ShowPoweredBy),
+		ShowTags:              types.BoolValue(sp.ShowTags),
+		CreatedAt:             optionalString(sp.CreatedAt),
+		UpdatedAt:             optionalString(sp.UpdatedAt),
+	}
+}
+
+func (d *StatusPagesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status_pages"
+}
+
+func (d *StatusPagesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists status pages, optionally filtered.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{Optional: true, Description: "Only return status pages whose title matches this regular expression"},
+			"published":  schema.BoolAttribute{Optional: true, Description: "Only return published (true) or unpublished (false) status pages"},
+			"monitor_id": schema.StringAttribute{Optional: true, Description: "Only return status pages showing this monitor"},
+			"status_pages": schema.ListNestedAttribute{
+				Computed:     true,
+				Description:  "Matching status pages",
+				NestedObject: schema.NestedAttributeObject{Attributes: statusPageItemAttributes()},
+			},
+		},
+	}
+}
+
+func (d *StatusPagesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*peekaping.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *peekaping.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *StatusPagesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data statusPagesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, diags := data.find(ctx, d.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.StatusPages = make([]statusPageItemModel, 0, len(items))
+	for i := range items {
+		data.StatusPages = append(data.StatusPages, statusPageItemFromAPI(&items[i]))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// find returns the status pages matching f.
+func (f *statusPageFilterModel) find(ctx context.Context, client *peekaping.Client) ([]peekaping.StatusPage, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	nameRe := compileFilterRegex(f.NameRegex, "name_regex", &diags)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	list, err := client.ListStatusPages(ctx)
+	if err != nil {
+		diags.AddError("list status pages failed", err.Error())
+		return nil, diags
+	}
+
+	var out []peekaping.StatusPage
+	for i := range list.Items {
+		sp := &list.Items[i]
+		if !matchesRegex(nameRe, sp.Title) || !matchesBool(f.Published, sp.Published) {
+			continue
+		}
+		if !f.MonitorID.IsNull() && !slices.Contains(sp.MonitorIDs, f.MonitorID.ValueString()) {
+			continue
+		}
+		out = append(out, *sp)
+	}
+	return out, diags
+}
@@ -6,10 +6,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
@@ -26,6 +27,7 @@ func NewTagDataSource() datasource.DataSource { return &TagDataSource{} }
 type tagDataSourceModel struct {
 	ID          types.String `tfsdk:"id"`
 	Name        types.String `tfsdk:"name"`
+	Match       types.String `tfsdk:"match"`
 	Color       types.String `tfsdk:"color"`
 	Description types.String `tfsdk:"description"`
 }
@@ -37,8 +39,15 @@ func (d *TagDataSource) Metadata(_ context.Context, req datasource.MetadataReque
 func (d *TagDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			"id":          schema.StringAttribute{Optional: true, Description: "Tag ID"},
-			"name":        schema.StringAttribute{Optional: true, Description: "Tag name"},
+			"id":   schema.StringAttribute{Optional: true, Description: "Tag ID"},
+			"name": schema.StringAttribute{Optional: true, Description: "Tag name"},
+			"match": schema.StringAttribute{
+				Optional:    true,
+				Description: "How name is matched: exact (default), case_insensitive, prefix or regex. Exactly one object must match.",
+				Validators: []validator.String{
+					matchModeValidator{},
+				},
+			},
 			"color":       schema.StringAttribute{Computed: true, Description: "Tag color"},
 			"description": schema.StringAttribute{Computed: true, Description: "Tag description"},
 		},
@@ -94,24 +103,27 @@ func (d *TagDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 		resp.Diagnostics.AddError("list tags failed", err.Error())
 		return
 	}
-	name := strings.ToLower(data.Name.ValueString())
-	for _, t := range list.Items {
-		if strings.ToLower(t.Name) == name || (name != "" && strings.Contains(strings.ToLower(t.Name), name)) {
-			data.ID = types.StringValue(t.ID)
-			data.Name = types.StringValue(t.Name)
-			if t.Color != "" {
-				data.Color = types.StringValue(t.Color)
-			} else {
-				data.Color = types.StringNull()
-			}
-			if t.Description != "" {
-				data.Description = types.StringValue(t.Description)
-			} else {
-				data.Description = types.StringNull()
-			}
-			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-			return
-		}
+	match, err := newNameMatcher(data.Match.ValueString(), data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Invalid Match Pattern", err.Error())
+		return
+	}
+	t, err := findUnique(list.Items, func(x *peekaping.Tag) (string, string) { return x.Name, x.ID }, match, "tag", data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("lookup failed", err.Error())
+		return
+	}
+	data.ID = types.StringValue(t.ID)
+	data.Name = types.StringValue(t.Name)
+	if t.Color != "" {
+		data.Color = types.StringValue(t.Color)
+	} else {
+		data.Color = types.StringNull()
+	}
+	if t.Description != "" {
+		data.Description = types.StringValue(t.Description)
+	} else {
+		data.Description = types.StringNull()
 	}
-	resp.Diagnostics.AddError("not found", "no tag matched the given criteria")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
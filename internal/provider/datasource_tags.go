@@ -0,0 +1,145 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
+)
+
+var _ datasource.DataSource = &TagsDataSource{}
+
+type TagsDataSource struct {
+	client *peekaping.Client
+}
+
+func NewTagsDataSource() datasource.DataSource { return &TagsDataSource{} }
+
+type tagsDataSourceModel struct {
+	tagFilterModel
+	Tags []tagItemModel `tfsdk:"tags"`
+}
+
+// tagFilterModel holds the filters shared by the tags data source and list resource.
+type tagFilterModel struct {
+	NameRegex types.String `tfsdk:"name_regex"`
+}
+
+// tagItemModel is a tag as exported by data sources.
+type tagItemModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Color       types.String `tfsdk:"color"`
+	Description types.String `tfsdk:"description"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+// tagItemAttributes returns the computed schema of tagItemModel.
+func tagItemAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id":          schema.StringAttribute{Computed: true, Description: "Tag ID"},
+		"name":        schema.StringAttribute{Computed: true, Description: "Tag name"},
+		"color":       schema.StringAttribute{Computed: true, Description: "Tag color in hex format"},
+		"description": schema.StringAttribute{Computed: true, Description: "Tag description"},
+		"created_at":  schema.StringAttribute{Computed: true, Description: "Creation timestamp"},
+		"updated_at":  schema.StringAttribute{Computed: true, Description: "Last update timestamp"},
+	}
+}
+
+func tagItemFromAPI(t *peekaping.Tag) tagItemModel {
+	return tagItemModel{
+		ID:          types.StringValue(t.ID),
+		Name:        types.StringValue(t.Name),
+		Color:       optionalString(t.Color),
+		Description: optionalString(t.Description),
+		CreatedAt:   optionalString(t.CreatedAt),
+		UpdatedAt:   optionalString(t.UpdatedAt),
+	}
+}
+
+func (d *TagsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tags"
+}
+
+func (d *TagsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists tags, optionally filtered.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{Optional: true, Description: "Only return tags whose name matches this regular expression"},
+			"tags": schema.ListNestedAttribute{
+				Computed:     true,
+				Description:  "Matching tags",
+				NestedObject: schema.NestedAttributeObject{Attributes: tagItemAttributes()},
+			},
+		},
+	}
+}
+
+func (d *TagsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*peekaping.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *peekaping.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *TagsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data tagsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, diags := data.find(ctx, d.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Tags = make([]tagItemModel, 0, len(items))
+	for i := range items {
+		data.Tags = append(data.Tags, tagItemFromAPI(&items[i]))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// find returns the tags matching f.
+func (f *tagFilterModel) find(ctx context.Context, client *peekaping.Client) ([]peekaping.Tag, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	nameRe := compileFilterRegex(f.NameRegex, "name_regex", &diags)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	list, err := client.ListTags(ctx)
+	if err != nil {
+		diags.AddError("list tags failed", err.Error())
+		return nil, diags
+	}
+
+	var out []peekaping.Tag
+	for i := range list.Items {
+		t := &list.Items[i]
+		if !matchesRegex(nameRe, t.Name) {
+			continue
+		}
+		out = append(out, *t)
+	}
+	return out, diags
+}
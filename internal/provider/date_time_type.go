@@ -0,0 +1,135 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
+)
+
+var (
+	_ basetypes.StringTypable                    = (*dateTimeType)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*dateTimeValue)(nil)
+	_ xattr.ValidateableAttribute                = (*dateTimeValue)(nil)
+)
+
+// dateTimeType is an RFC 3339 timestamp. Values denoting the same instant are
+// semantically equal, so server-side reformatting or offset changes cause no diff.
+type dateTimeType struct {
+	basetypes.StringType
+}
+
+func (t dateTimeType) String() string {
+	return "provider.dateTimeType"
+}
+
+func (t dateTimeType) ValueType(_ context.Context) attr.Value {
+	return dateTimeValue{}
+}
+
+func (t dateTimeType) Equal(o attr.Type) bool {
+	other, ok := o.(dateTimeType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t dateTimeType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return dateTimeValue{StringValue: in}, nil
+}
+
+func (t dateTimeType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return dateTimeValue{StringValue: stringValue}, nil
+}
+
+type dateTimeValue struct {
+	basetypes.StringValue
+}
+
+func newDateTimeNull() dateTimeValue { return dateTimeValue{StringValue: basetypes.NewStringNull()} }
+
+func newDateTimeValue(s string) dateTimeValue {
+	return dateTimeValue{StringValue: basetypes.NewStringValue(s)}
+}
+
+func (v dateTimeValue) Type(_ context.Context) attr.Type {
+	return dateTimeType{}
+}
+
+func (v dateTimeValue) Equal(o attr.Value) bool {
+	other, ok := o.(dateTimeValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// Time parses the value. It fails for null, unknown and malformed values.
+func (v dateTimeValue) Time() (time.Time, error) {
+	return time.Parse(time.RFC3339, v.ValueString())
+}
+
+func (v dateTimeValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(dateTimeValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+	a, errA := v.Time()
+	b, errB := newValue.Time()
+	if errA != nil || errB != nil {
+		return v.ValueString() == newValue.ValueString(), diags
+	}
+	return a.Equal(b), diags
+}
+
+func (v dateTimeValue) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+	if _, err := v.Time(); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Date-Time",
+			fmt.Sprintf("%q is not an RFC 3339 timestamp such as 2030-01-01T02:00:00Z or 2030-01-01T03:00:00+01:00.", v.ValueString()),
+		)
+	}
+}
+
+// normalizeDateTime converts a server timestamp to RFC 3339. Timestamps without an offset
+// are read in timezone (UTC if empty or unknown). Unparseable input is returned as is.
+func normalizeDateTime(s, timezone string) string {
+	loc := time.UTC
+	if timezone != "" {
+		if l, err := time.LoadLocation(timezone); err == nil {
+			loc = l
+		}
+	}
+	if t, err := peekaping.ParseServerTime(s, loc); err == nil {
+		return t.Format(time.RFC3339)
+	}
+	return s
+}
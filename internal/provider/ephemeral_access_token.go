@@ -0,0 +1,162 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
+)
+
+var _ ephemeral.EphemeralResource = &AccessTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &AccessTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithValidateConfig = &AccessTokenEphemeralResource{}
+
+type AccessTokenEphemeralResource struct {
+	client *peekaping.Client
+}
+
+func NewAccessTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &AccessTokenEphemeralResource{}
+}
+
+type accessTokenModel struct {
+	Email        types.String `tfsdk:"email"`
+	Password     types.String `tfsdk:"password"`
+	TotpToken    types.String `tfsdk:"totp_token"`
+	Endpoint     types.String `tfsdk:"endpoint"`
+	AccessToken  types.String `tfsdk:"access_token"`
+	RefreshToken types.String `tfsdk:"refresh_token"`
+	ExpiresAt    types.String `tfsdk:"expires_at"`
+}
+
+func (r *AccessTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_token"
+}
+
+func (r *AccessTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Logs in to Peekaping and returns a short-lived access token for other tooling. " +
+			"The token is never stored in plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"email": schema.StringAttribute{
+				Optional:    true,
+				Description: "Email to log in with. Defaults to the provider credentials",
+			},
+			"password": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password to log in with. Required when email is set",
+			},
+			"totp_token": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "TOTP token for 2FA login",
+			},
+			"endpoint": schema.StringAttribute{
+				Computed:    true,
+				Description: "Base URL of the Peekaping server the token is valid for",
+			},
+			"access_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Bearer token for the Authorization header",
+			},
+			"refresh_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Token to obtain a new access token from /api/v1/auth/refresh",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Expiry of the access token (RFC 3339), if the server reports one",
+			},
+		},
+	}
+}
+
+func (r *AccessTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*peekaping.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *peekaping.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *AccessTokenEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var data accessTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.Email.IsUnknown() || data.Password.IsUnknown() {
+		return
+	}
+	if data.Email.IsNull() != data.Password.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password"),
+			"Incomplete Credentials",
+			"email and password must be set together. Omit both to use the provider credentials.",
+		)
+	}
+}
+
+func (r *AccessTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data accessTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokens, err := r.client.IssueTokens(ctx, data.Email.ValueString(), data.Password.ValueString(), data.TotpToken.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("login failed", err.Error())
+		return
+	}
+
+	data.Endpoint = types.StringValue(r.client.Endpoint)
+	data.AccessToken = types.StringValue(tokens.AccessToken)
+	data.RefreshToken = types.StringValue(tokens.RefreshToken)
+	data.ExpiresAt = types.StringNull()
+	if exp, ok := jwtExpiry(tokens.AccessToken); ok {
+		data.ExpiresAt = types.StringValue(exp.UTC().Format(time.RFC3339))
+	}
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// jwtExpiry reads the exp claim of a JWT without verifying it.
+func jwtExpiry(token string) (time.Time, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, false
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}, false
+	}
+	var claims struct {
+		Exp *int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == nil {
+		return time.Time{}, false
+	}
+	return time.Unix(*claims.Exp, 0), true
+}
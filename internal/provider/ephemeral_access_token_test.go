@@ -0,0 +1,26 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+)
+
+// TestJWTExpiry tests reading the exp claim of access tokens.
+func TestJWTExpiry(t *testing.T) {
+	token := func(payload string) string {
+		return "e30." + base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".sig"
+	}
+
+	if exp, ok := jwtExpiry(token(`{"sub":"1","exp":1767225600}`)); !ok || !exp.Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("jwtExpiry = %s, %v", exp, ok)
+	}
+	for _, tok := range []string{token(`{"sub":"1"}`), token("not json"), "opaque-token", "a.!!.c"} {
+		if _, ok := jwtExpiry(tok); ok {
+			t.Errorf("jwtExpiry(%q) ok, want no expiry", tok)
+		}
+	}
+}
@@ -0,0 +1,235 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &MaintenanceWindowsFunction{}
+
+// maintenanceWindowsMaxCount caps the count argument of maintenance_windows.
+const maintenanceWindowsMaxCount = 100
+
+// maintenanceWindowsOptions are the schedule attributes accepted in the options argument
+// of maintenance_windows; cron and duration are positional arguments.
+var maintenanceWindowsOptions = []string{
+	"start_date_time", "end_date_time", "weekdays", "days_of_month", "interval_day", "start_time", "end_time",
+}
+
+type MaintenanceWindowsFunction struct{}
+
+func NewMaintenanceWindowsFunction() function.Function { return &MaintenanceWindowsFunction{} }
+
+func (f *MaintenanceWindowsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "maintenance_windows"
+}
+
+func (f *MaintenanceWindowsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Preview the windows of a maintenance schedule",
+		Description: "Returns the next windows of a maintenance schedule as a list of objects with RFC 3339 start and end " +
+			"timestamps, using the same strategy rules and scheduling as the next_windows attribute of peekaping_maintenance.",
+		Parameters: []function.Parameter{
+			function.StringParameter{Name: "strategy", Description: "Maintenance strategy: " + strings.Join(maintenanceStrategies, ", ")},
+			function.StringParameter{Name: "cron", AllowNullValue: true, Description: "Cron expression producing the window start times. Only for the cron strategy"},
+			function.StringParameter{Name: "timezone", AllowNullValue: true, Description: "IANA timezone the schedule is evaluated in. Null means UTC"},
+			function.Int64Parameter{Name: "duration", AllowNullValue: true, Description: "Window length in minutes. Only for the cron strategy"},
+			function.StringParameter{Name: "from", Description: "RFC 3339 timestamp to list windows from. A window in effect at this time is included"},
+			function.Int64Parameter{Name: "count", Description: fmt.Sprintf("Number of windows to return, 1-%d", maintenanceWindowsMaxCount)},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:           "options",
+			AllowNullValue: true,
+			Description: "At most one object with the other schedule attributes of peekaping_maintenance: " +
+				strings.Join(maintenanceWindowsOptions, ", ") + `, e.g. { weekdays = [6], start_time = "02:00", end_time = "04:00" }`,
+		},
+		Return: function.ListReturn{ElementType: types.ObjectType{AttrTypes: maintenanceWindowAttrTypes}},
+	}
+}
+
+func (f *MaintenanceWindowsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var strategy, from string
+	var cron, timezone types.String
+	var duration types.Int64
+	var count int64
+	var options types.Tuple
+	resp.Error = req.Arguments.Get(ctx, &strategy, &cron, &timezone, &duration, &from, &count, &options)
+	if resp.Error != nil {
+		return
+	}
+
+	if !slices.Contains(maintenanceStrategies, strategy) {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("strategy %q is not supported; expected one of %s", strategy, strings.Join(maintenanceStrategies, ", ")))
+		return
+	}
+	if cron.ValueString() != "" {
+		if _, err := parseCron(cron.ValueString()); err != nil {
+			resp.Error = function.NewArgumentFuncError(1, err.Error())
+			return
+		}
+	}
+	loc := time.UTC
+	if timezone.ValueString() != "" {
+		if err := checkTimezone(timezone.ValueString()); err != nil {
+			resp.Error = function.NewArgumentFuncError(2, err.Error())
+			return
+		}
+		loc, _ = time.LoadLocation(timezone.ValueString())
+	}
+	if !duration.IsNull() && duration.ValueInt64() < 1 {
+		resp.Error = function.NewArgumentFuncError(3, "duration must be at least 1 minute")
+		return
+	}
+	start, err := time.Parse(time.RFC3339, from)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(4, fmt.Sprintf("from must be an RFC 3339 timestamp: %s", err))
+		return
+	}
+	if count < 1 || count > maintenanceWindowsMaxCount {
+		resp.Error = function.NewArgumentFuncError(5, fmt.Sprintf("count must be between 1 and %d", maintenanceWindowsMaxCount))
+		return
+	}
+
+	schedule := maintenanceSchedule{
+		Strategy: strategy,
+		Cron:     cron.ValueString(),
+		Location: loc,
+		Duration: time.Duration(duration.ValueInt64()) * time.Minute,
+	}
+	values := map[string]attr.Value{"cron": cron, "duration": duration}
+	if err := scheduleOptions(&schedule, values, options); err != nil {
+		resp.Error = function.NewArgumentFuncError(6, err.Error())
+		return
+	}
+	if diags := checkMaintenanceStrategy(strategy, values); diags.HasError() {
+		var msgs []string
+		for _, d := range diags.Errors() {
+			msgs = append(msgs, d.Detail())
+		}
+		resp.Error = function.NewFuncError(strings.Join(msgs, "; "))
+		return
+	}
+
+	windows, err := schedule.nextWindows(start, int(count))
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, maintenanceWindowsToList(windows))
+}
+
+// scheduleOptions copies the options argument of maintenance_windows into s, and
+// records which schedule attributes are set in values for checkMaintenanceStrategy.
+func scheduleOptions(s *maintenanceSchedule, values map[string]attr.Value, options types.Tuple) error {
+	for _, name := range maintenanceWindowsOptions {
+		values[name] = types.StringNull()
+	}
+	switch elems := options.Elements(); len(elems) {
+	case 0:
+		return nil
+	case 1:
+	default:
+		return fmt.Errorf("options can be given at most once")
+	}
+
+	v, err := goValue(options.Elements()[0])
+	if err != nil || v == nil {
+		return err
+	}
+	fields, ok := v.(map[string]any)
+	if !ok {
+		return fmt.Errorf("options must be an object")
+	}
+	for key, value := range fields {
+		if !slices.Contains(maintenanceWindowsOptions, key) {
+			return fmt.Errorf("unsupported option %q; expected %s", key, strings.Join(maintenanceWindowsOptions, ", "))
+		}
+		if value == nil {
+			continue
+		}
+		var err error
+		switch key {
+		case "start_date_time", "end_date_time":
+			var str string
+			var t time.Time
+			if str, err = optionString(key, value); err == nil {
+				if t, err = time.Parse(time.RFC3339, str); err != nil {
+					err = fmt.Errorf("%s must be an RFC 3339 timestamp: %w", key, err)
+				}
+			}
+			if key == "start_date_time" {
+				s.Start = t
+			} else {
+				s.End = t
+			}
+		case "weekdays":
+			s.Weekdays, err = optionDays(key, value)
+		case "days_of_month":
+			s.DaysOfMonth, err = optionDays(key, value)
+		case "interval_day":
+			n, ok := value.(int64)
+			if !ok {
+				err = fmt.Errorf("%s must be a whole number", key)
+			}
+			s.IntervalDay = int(n)
+		case "start_time", "end_time":
+			var str string
+			if str, err = optionString(key, value); err == nil {
+				_, _, err = parseClock(str)
+			}
+			if key == "start_time" {
+				s.StartTime = str
+			} else {
+				s.EndTime = str
+			}
+		}
+		if err != nil {
+			return err
+		}
+		values[key] = types.StringValue(fmt.Sprint(value))
+	}
+	if len(s.Weekdays) == 0 {
+		values["weekdays"] = types.StringNull()
+	}
+	if len(s.DaysOfMonth) == 0 {
+		values["days_of_month"] = types.StringNull()
+	}
+	return nil
+}
+
+func optionString(key string, v any) (string, error) {
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("%s must be a string", key)
+	}
+	return s, nil
+}
+
+func optionDays(key string, v any) ([]int, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("%s must be a list of numbers", key)
+	}
+	out := make([]int, 0, len(list))
+	for _, x := range list {
+		n, ok := x.(int64)
+		if !ok {
+			return nil, fmt.Errorf("%s must contain whole numbers, got %v", key, x)
+		}
+		if err := checkMaintenanceDay(key, int(n)); err != nil {
+			return nil, err
+		}
+		out = append(out, int(n))
+	}
+	return out, nil
+}
@@ -0,0 +1,194 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// monitorConfigArg is a positional argument of a config function, stored under key.
+type monitorConfigArg struct {
+	key         string
+	number      bool
+	description string
+}
+
+// monitorConfigFunction builds the config JSON of one monitor type from positional
+// arguments and, if options is set, an object of further config fields.
+type monitorConfigFunction struct {
+	name        string
+	monitorType string
+	summary     string
+	description string
+	args        []monitorConfigArg
+	// options describes the trailing options argument; empty if there is none.
+	options string
+}
+
+func newMonitorConfigFunction(f monitorConfigFunction) func() function.Function {
+	return func() function.Function {
+		return &f
+	}
+}
+
+func (f *monitorConfigFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = f.name
+}
+
+func (f *monitorConfigFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	var params []function.Parameter
+	for _, a := range f.args {
+		if a.number {
+			params = append(params, function.Int64Parameter{Name: a.key, Description: a.description})
+		} else {
+			params = append(params, function.StringParameter{Name: a.key, Description: a.description})
+		}
+	}
+	if f.options != "" {
+		params = append(params, function.DynamicParameter{
+			Name:           "options",
+			AllowNullValue: true,
+			Description:    f.options,
+		})
+	}
+
+	resp.Definition = function.Definition{
+		Summary:     f.summary,
+		Description: f.description,
+		Parameters:  params,
+		Return:      function.StringReturn{},
+	}
+}
+
+func (f *monitorConfigFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	cfg := map[string]any{}
+	for i, a := range f.args {
+		if a.number {
+			var v types.Int64
+			resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.GetArgument(ctx, i, &v))
+			cfg[a.key] = v.ValueInt64()
+		} else {
+			var v types.String
+			resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.GetArgument(ctx, i, &v))
+			cfg[a.key] = v.ValueString()
+		}
+	}
+	if resp.Error != nil {
+		return
+	}
+
+	if f.options != "" {
+		idx := len(f.args)
+		var options types.Dynamic
+		resp.Error = req.Arguments.GetArgument(ctx, idx, &options)
+		if resp.Error != nil {
+			return
+		}
+		fields, err := optionFields(options)
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(int64(idx), err.Error())
+			return
+		}
+		for k, v := range fields {
+			if _, ok := cfg[k]; ok {
+				resp.Error = function.NewArgumentFuncError(int64(idx), fmt.Sprintf("%s is set by an argument and cannot be set in options", k))
+				return
+			}
+			cfg[k] = v
+		}
+	}
+
+	out, err := buildMonitorConfig(f.monitorType, cfg)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, out)
+}
+
+// optionFields decodes the options argument, which must be null, an object or a map.
+func optionFields(options types.Dynamic) (map[string]any, error) {
+	if options.IsNull() || options.IsUnderlyingValueNull() {
+		return nil, nil
+	}
+	v, err := goValue(options.UnderlyingValue())
+	if err != nil {
+		return nil, err
+	}
+	fields, ok := v.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("options must be an object")
+	}
+	return fields, nil
+}
+
+// goValue converts a Terraform value into its JSON-compatible Go equivalent.
+func goValue(v attr.Value) (any, error) {
+	if v.IsNull() {
+		return nil, nil
+	}
+	if v.IsUnknown() {
+		return nil, fmt.Errorf("options must be known")
+	}
+	switch t := v.(type) {
+	case types.String:
+		return t.ValueString(), nil
+	case types.Bool:
+		return t.ValueBool(), nil
+	case types.Int64:
+		return t.ValueInt64(), nil
+	case types.Float64:
+		return t.ValueFloat64(), nil
+	case types.Number:
+		n := t.ValueBigFloat()
+		if i, acc := n.Int64(); acc == big.Exact {
+			return i, nil
+		}
+		f, _ := n.Float64()
+		return f, nil
+	case types.Dynamic:
+		return goValue(t.UnderlyingValue())
+	case types.List:
+		return goValues(t.Elements())
+	case types.Set:
+		return goValues(t.Elements())
+	case types.Tuple:
+		return goValues(t.Elements())
+	case types.Object:
+		return goFields(t.Attributes())
+	case types.Map:
+		return goFields(t.Elements())
+	}
+	return nil, fmt.Errorf("unsupported value %s", v)
+}
+
+func goValues(elems []attr.Value) ([]any, error) {
+	out := make([]any, 0, len(elems))
+	for _, e := range elems {
+		v, err := goValue(e)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+func goFields(attrs map[string]attr.Value) (map[string]any, error) {
+	out := make(map[string]any, len(attrs))
+	for k, e := range attrs {
+		v, err := goValue(e)
+		if err != nil {
+			return nil, err
+		}
+		out[k] = v
+	}
+	return out, nil
+}
@@ -0,0 +1,260 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TestMonitorConfigFunctions tests defaults, validation and normalization of the config functions.
+func TestMonitorConfigFunctions(t *testing.T) {
+	ctx := context.Background()
+	funcs := map[string]function.Function{}
+	for _, newFn := range (&PeekapingProvider{}).Functions(ctx) {
+		fn := newFn()
+		var meta function.MetadataResponse
+		fn.Metadata(ctx, function.MetadataRequest{}, &meta)
+		funcs[meta.Name] = fn
+	}
+
+	options := func(attrs map[string]attr.Value) types.Dynamic {
+		typ := map[string]attr.Type{}
+		for k, v := range attrs {
+			typ[k] = v.Type(ctx)
+		}
+		return types.DynamicValue(types.ObjectValueMust(typ, attrs))
+	}
+
+	tests := []struct {
+		name      string
+		function  string
+		args      []attr.Value
+		want      string
+		wantError string
+	}{
+		{
+			"HTTP defaults", "http_config",
+			[]attr.Value{types.StringValue("https://example.com/health"), types.DynamicNull()},
+			`{"accepted_statuscodes":["2XX"],"authMethod":"none","encoding":"json","method":"GET","url":"https://example.com/health"}`, "",
+		},
+		{
+			"HTTP options override defaults", "http_config",
+			[]attr.Value{types.StringValue("https://example.com"), options(map[string]attr.Value{
+				"method":            types.StringValue("POST"),
+				"check_cert_expiry": types.BoolValue(true),
+				"body":              types.StringNull(),
+			})},
+			`{"accepted_statuscodes":["2XX"],"authMethod":"none","check_cert_expiry":true,"encoding":"json","method":"POST","url":"https://example.com"}`, "",
+		},
+		{
+			"HTTP OAuth2 client credentials", "http_config",
+			[]attr.Value{types.StringValue("https://example.com"), options(map[string]attr.Value{
+				"authMethod":      types.StringValue("oauth2-cc"),
+				"oauth_token_url": types.StringValue("https://auth.example.com/token"),
+			})},
+			`{"accepted_statuscodes":["2XX"],"authMethod":"oauth2-cc","encoding":"json","method":"GET","oauth_token_url":"https://auth.example.com/token","url":"https://example.com"}`, "",
+		},
+		{
+			"HTTP invalid method", "http_config",
+			[]attr.Value{types.StringValue("https://example.com"), options(map[string]attr.Value{"method": types.StringValue("FETCH")})},
+			"", "method FETCH must be one of",
+		},
+		{
+			"HTTP relative URL", "http_config",
+			[]attr.Value{types.StringValue("example.com"), types.DynamicNull()},
+			"", "absolute http or https URL",
+		},
+		{
+			"URL in options", "http_config",
+			[]attr.Value{types.StringValue("https://example.com"), options(map[string]attr.Value{"url": types.StringValue("https://other.example.com")})},
+			"", "url is set by an argument",
+		},
+		{
+			"HTTP keyword", "http_keyword_config",
+			[]attr.Value{types.StringValue("https://example.com"), types.StringValue("Welcome"), options(map[string]attr.Value{
+				"invert_keyword": types.BoolValue(true),
+			})},
+			`{"accepted_statuscodes":["2XX"],"authMethod":"none","encoding":"json","invert_keyword":true,"keyword":"Welcome","method":"GET","url":"https://example.com"}`, "",
+		},
+		{
+			"HTTP keyword empty keyword", "http_keyword_config",
+			[]attr.Value{types.StringValue("https://example.com"), types.StringValue(""), types.DynamicNull()},
+			"", `require "keyword"`,
+		},
+		{
+			"HTTP JSON query", "http_json_query_config",
+			[]attr.Value{types.StringValue("https://example.com/status"), types.StringValue("$.status"), options(map[string]attr.Value{
+				"json_condition": types.StringValue("=="),
+				"expected_value": types.StringValue("ok"),
+			})},
+			`{"accepted_statuscodes":["2XX"],"authMethod":"none","encoding":"json","expected_value":"ok","json_condition":"==","json_query":"$.status","method":"GET","url":"https://example.com/status"}`, "",
+		},
+		{
+			"HTTP JSON query invalid status code", "http_json_query_config",
+			[]attr.Value{types.StringValue("https://example.com"), types.StringValue("$.ok"), options(map[string]attr.Value{
+				"accepted_statuscodes": types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("2xx")}),
+			})},
+			"", "accepted_statuscodes entry 2xx",
+		},
+		{
+			"Ping", "ping_config",
+			[]attr.Value{types.StringValue("10.0.0.1"), options(map[string]attr.Value{"packet_size": types.Int64Value(56)})},
+			`{"host":"10.0.0.1","packet_size":56}`, "",
+		},
+		{
+			"Ping URL as host", "ping_config",
+			[]attr.Value{types.StringValue("https://example.com"), types.DynamicNull()},
+			"", "must be a hostname or IP address",
+		},
+		{
+			"TCP", "tcp_config",
+			[]attr.Value{types.StringValue("db.example.com"), types.Int64Value(5432)},
+			`{"host":"db.example.com","port":5432}`, "",
+		},
+		{
+			"TCP invalid port", "tcp_config",
+			[]attr.Value{types.StringValue("db.example.com"), types.Int64Value(70000)},
+			"", "between 1 and 65535",
+		},
+		{
+			"DNS", "dns_config",
+			[]attr.Value{types.StringValue("example.com"), options(map[string]attr.Value{"resolve_type": types.StringValue("MX")})},
+			`{"host":"example.com","port":53,"resolve_type":"MX","resolver_server":"1.1.1.1"}`, "",
+		},
+		{
+			"DNS invalid resolver", "dns_config",
+			[]attr.Value{types.StringValue("example.com"), options(map[string]attr.Value{"resolver_server": types.StringValue("dns.google")})},
+			"", "must be an IP address",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+			funcs[tt.function].Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(tt.args)}, &resp)
+			if tt.wantError != "" {
+				if resp.Error == nil || !strings.Contains(resp.Error.Error(), tt.wantError) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantError, resp.Error)
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %v", resp.Error)
+			}
+			if got := resp.Result.Value().(types.String).ValueString(); got != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+// TestMonitorResourceConfigRules tests that peekaping_monitor checks a hand-written
+// config with the same rules as the config functions.
+func TestMonitorResourceConfigRules(t *testing.T) {
+	ctx := context.Background()
+	r := &MonitorResource{}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	validate := func(monitorType, cfg string) diag.Diagnostics {
+		values := map[string]tftypes.Value{}
+		for name, typ := range objType.AttributeTypes {
+			values[name] = tftypes.NewValue(typ, nil)
+		}
+		values["name"] = tftypes.NewValue(tftypes.String, "check")
+		values["type"] = tftypes.NewValue(tftypes.String, monitorType)
+		values["config"] = tftypes.NewValue(tftypes.String, cfg)
+		config := tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objType, values)}
+		var resp resource.ValidateConfigResponse
+		r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config}, &resp)
+		return resp.Diagnostics
+	}
+
+	for _, tt := range []struct {
+		monitorType, config, wantError string
+	}{
+		{"http", `{"url":"https://example.com","method":"GET","authMethod":"oauth2-cc"}`, ""},
+		{"http", `{"url":"https://example.com","authMethod":"basic"}`, ""},
+		{"http", `{"url":"https://example.com","method":"FETCH"}`, "method FETCH must be one of"},
+		{"http-keyword", `{"url":"https://example.com"}`, `require "keyword"`},
+		{"tcp", `{"host":"db.example.com","port":70000}`, "between 1 and 65535"},
+		{"dns", `{"host":"example.com","resolve_type":"MX"}`, ""},
+		{"dns", `{"host":"example.com","resolver_server":"dns.google"}`, "must be an IP address"},
+		{"docker", `{"container_id":"web"}`, ""},
+	} {
+		diags := validate(tt.monitorType, tt.config)
+		if tt.wantError == "" {
+			if diags.HasError() {
+				t.Errorf("%s %s: unexpected error %v", tt.monitorType, tt.config, diags)
+			}
+			continue
+		}
+		if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), tt.wantError) {
+			t.Errorf("%s %s: expected error containing %q, got %v", tt.monitorType, tt.config, tt.wantError, diags)
+		}
+		var cfg map[string]any
+		_ = json.Unmarshal([]byte(tt.config), &cfg)
+		if _, err := buildMonitorConfig(tt.monitorType, cfg); err == nil || !strings.Contains(err.Error(), tt.wantError) {
+			t.Errorf("%s %s: config functions should reject it the same way, got %v", tt.monitorType, tt.config, err)
+		}
+	}
+}
+
+// TestMonitorResourceConfigRulesBreaking tests that configs which only needed to be valid
+// JSON before the per-type rules applied to peekaping_monitor are now rejected at plan time.
+func TestMonitorResourceConfigRulesBreaking(t *testing.T) {
+	ctx := context.Background()
+	r := &MonitorResource{}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	for _, tt := range []struct {
+		monitorType, config string
+	}{
+		{"tcp", `{"host":"db.example.com","port":70000}`},
+		{"http-keyword", `{"url":"https://example.com"}`},
+		{"http", `{"url":"example.com"}`},
+	} {
+		// The JSON check that used to be the only one still passes.
+		var jsonResp validator.StringResponse
+		monitorConfigValidator{}.ValidateString(ctx, validator.StringRequest{
+			Path:        path.Root("config"),
+			ConfigValue: types.StringValue(tt.config),
+		}, &jsonResp)
+		if jsonResp.Diagnostics.HasError() {
+			t.Errorf("%s %s: rejected as invalid JSON: %v", tt.monitorType, tt.config, jsonResp.Diagnostics)
+			continue
+		}
+
+		values := map[string]tftypes.Value{}
+		for name, typ := range objType.AttributeTypes {
+			values[name] = tftypes.NewValue(typ, nil)
+		}
+		values["name"] = tftypes.NewValue(tftypes.String, "check")
+		values["type"] = tftypes.NewValue(tftypes.String, tt.monitorType)
+		values["config"] = tftypes.NewValue(tftypes.String, tt.config)
+		var resp resource.ValidateConfigResponse
+		r.ValidateConfig(ctx, resource.ValidateConfigRequest{
+			Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objType, values)},
+		}, &resp)
+		if !resp.Diagnostics.HasError() {
+			t.Errorf("%s %s: expected a plan-time error", tt.monitorType, tt.config)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
+)
+
+// importLookup resolves a human-readable import ID of the form prefix:value (e.g.
+// name:checkout-api) to an object ID by listing objects and matching value exactly.
+// Any other import ID is returned unchanged without listing.
+func importLookup[T any](importID, prefix string, list func() ([]T, error), key func(*T) (name, id string), kind string) (string, error) {
+	value, ok := strings.CutPrefix(importID, prefix+":")
+	if !ok {
+		return importID, nil
+	}
+	items, err := list()
+	if err != nil {
+		return "", err
+	}
+	found, err := findUnique(items, key, func(s string) bool { return s == value }, kind, value)
+	if err != nil {
+		return "", err
+	}
+	_, id := key(found)
+	return id, nil
+}
+
+// proxyImportLookup resolves a host:port import ID to a proxy ID. Import IDs that
+// aren't host:port are returned unchanged without listing.
+func proxyImportLookup(importID string, list func() ([]peekaping.Proxy, error)) (string, error) {
+	host, port, err := net.SplitHostPort(importID)
+	if err != nil || host == "" {
+		return importID, nil
+	}
+	if _, err := strconv.Atoi(port); err != nil {
+		return importID, nil
+	}
+	items, err := list()
+	if err != nil {
+		return "", err
+	}
+	want := net.JoinHostPort(host, port)
+	found, err := findUnique(items, proxyHostPort, func(s string) bool { return strings.EqualFold(s, want) }, "proxy", want)
+	if err != nil {
+		return "", err
+	}
+	return found.ID, nil
+}
+
+func proxyHostPort(p *peekaping.Proxy) (string, string) {
+	return net.JoinHostPort(p.Host, strconv.Itoa(p.Port)), p.ID
+}
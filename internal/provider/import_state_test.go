@@ -0,0 +1,68 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
+)
+
+// TestImportLookup tests resolution of human-readable import IDs.
+func TestImportLookup(t *testing.T) {
+	monitors := []peekaping.Monitor{
+		{ID: "1", Name: "checkout-api"},
+		{ID: "2", Name: "dup"},
+		{ID: "3", Name: "dup"},
+	}
+	proxies := []peekaping.Proxy{
+		{ID: "p1", Host: "proxy.example.com", Port: 8080},
+		{ID: "p2", Host: "::1", Port: 3128},
+	}
+	listMonitors := func() ([]peekaping.Monitor, error) { return monitors, nil }
+	listProxies := func() ([]peekaping.Proxy, error) { return proxies, nil }
+	key := func(m *peekaping.Monitor) (string, string) { return m.Name, m.ID }
+
+	tests := []struct {
+		name      string
+		importID  string
+		proxy     bool
+		wantID    string
+		wantError string
+	}{
+		{"Plain ID", "abc-123", false, "abc-123", ""},
+		{"By name", "name:checkout-api", false, "1", ""},
+		{"Unknown name", "name:nope", false, "", "no monitor matched"},
+		{"Ambiguous name", "name:dup", false, "", `"dup" (id 2), "dup" (id 3)`},
+		{"Proxy plain ID", "p1", true, "p1", ""},
+		{"Proxy host:port", "PROXY.example.com:8080", true, "p1", ""},
+		{"Proxy IPv6", "[::1]:3128", true, "p2", ""},
+		{"Proxy unknown", "proxy.example.com:9999", true, "", "no proxy matched"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			var err error
+			if tt.proxy {
+				got, err = proxyImportLookup(tt.importID, listProxies)
+			} else {
+				got, err = importLookup(tt.importID, "name", listMonitors, key, "monitor")
+			}
+			if tt.wantError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.wantID {
+				t.Errorf("expected ID %s, got %s", tt.wantID, got)
+			}
+		})
+	}
+}
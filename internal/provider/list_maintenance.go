@@ -0,0 +1,65 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
+)
+
+var _ list.ListResourceWithConfigure = &MaintenanceListResource{}
+
+type MaintenanceListResource struct {
+	client *peekaping.Client
+}
+
+func NewMaintenanceListResource() list.ListResource { return &MaintenanceListResource{} }
+
+func (r *MaintenanceListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_maintenance"
+}
+
+func (r *MaintenanceListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists maintenance windows for terraform query, optionally filtered.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{Optional: true, Description: "Only list maintenance windows whose title matches this regular expression"},
+			"strategy":   schema.StringAttribute{Optional: true, Description: "Only list maintenance windows with this strategy"},
+			"monitor_id": schema.StringAttribute{Optional: true, Description: "Only list maintenance windows covering this monitor"},
+			"active":     schema.BoolAttribute{Optional: true, Description: "Only list active (true) or inactive (false) maintenance windows"},
+		},
+	}
+}
+
+func (r *MaintenanceListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := configureListClient(req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *MaintenanceListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var filter maintenanceFilterModel
+	diags := req.Config.Get(ctx, &filter)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items, diags := filter.find(ctx, r.client)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, r.client, items, func(x *peekaping.Maintenance) (string, string) { return x.Title, x.ID }, func(x *peekaping.Maintenance) any {
+		var state maintenanceResourceModel
+		setModelFromMaintenance(&state, x)
+		return &state
+	})
+}
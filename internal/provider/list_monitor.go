@@ -0,0 +1,73 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
+)
+
+var _ list.ListResourceWithConfigure = &MonitorListResource{}
+
+type MonitorListResource struct {
+	client *peekaping.Client
+}
+
+func NewMonitorListResource() list.ListResource { return &MonitorListResource{} }
+
+func (r *MonitorListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitor"
+}
+
+func (r *MonitorListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists monitors for terraform query, optionally filtered.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{Optional: true, Description: "Only list monitors whose name matches this regular expression"},
+			"type":       schema.StringAttribute{Optional: true, Description: "Only list monitors of this type"},
+			"tag":        schema.StringAttribute{Optional: true, Description: "Only list monitors carrying this tag, given by ID or name"},
+			"tag_value":  schema.StringAttribute{Optional: true, Description: "Only list monitors carrying a tag with this per-monitor value, or with tag, carrying that tag with this value"},
+			"active":     schema.BoolAttribute{Optional: true, Description: "Only list active (true) or paused (false) monitors"},
+			"status":     schema.Int64Attribute{Optional: true, Description: "Only list monitors with this status (0=down, 1=up, 2=pending, 3=maintenance)"},
+		},
+	}
+}
+
+func (r *MonitorListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := configureListClient(req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *MonitorListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var filter monitorFilterModel
+	diags := req.Config.Get(ctx, &filter)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items, diags := filter.find(ctx, r.client)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, r.client, items, func(x *peekaping.Monitor) (string, string) { return x.Name, x.ID }, func(x *peekaping.Monitor) any {
+		var state monitorResourceModel
+		setModelFromMonitor(ctx, &state, x)
+		state.Active = types.BoolValue(x.Active)
+		state.PushURL = types.StringNull()
+		if x.Type == peekaping.MonitorPush && x.PushToken != "" {
+			state.PushURL = types.StringValue(r.client.PushURL(x.PushToken))
+		}
+		return &state
+	})
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
+)
+
+var _ list.ListResourceWithConfigure = &NotificationListResource{}
+
+type NotificationListResource struct {
+	client *peekaping.Client
+}
+
+func NewNotificationListResource() list.ListResource { return &NotificationListResource{} }
+
+func (r *NotificationListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification"
+}
+
+func (r *NotificationListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists notification channels for terraform query, optionally filtered.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{Optional: true, Description: "Only list notifications whose name matches this regular expression"},
+			"type":       schema.StringAttribute{Optional: true, Description: "Only list notifications of this type"},
+			"active":     schema.BoolAttribute{Optional: true, Description: "Only list active (true) or inactive (false) notifications"},
+		},
+	}
+}
+
+func (r *NotificationListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := configureListClient(req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *NotificationListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var filter notificationFilterModel
+	diags := req.Config.Get(ctx, &filter)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items, diags := filter.find(ctx, r.client)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, r.client, items, func(x *peekaping.Notification) (string, string) { return x.Name, x.ID }, func(x *peekaping.Notification) any {
+		var state notificationResourceModel
+		setModelFromNotification(&state, x)
+		return &state
+	})
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
+)
+
+var _ list.ListResourceWithConfigure = &ProxyListResource{}
+
+type ProxyListResource struct {
+	client *peekaping.Client
+}
+
+func NewProxyListResource() list.ListResource { return &ProxyListResource{} }
+
+func (r *ProxyListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_proxy"
+}
+
+func (r *ProxyListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists proxies for terraform query, optionally filtered. Passwords are never listed.",
+		Attributes: map[string]schema.Attribute{
+			"host_regex": schema.StringAttribute{Optional: true, Description: "Only list proxies whose host matches this regular expression"},
+			"protocol":   schema.StringAttribute{Optional: true, Description: "Only list proxies using this protocol"},
+			"auth":       schema.BoolAttribute{Optional: true, Description: "Only list proxies with (true) or without (false) authentication"},
+		},
+	}
+}
+
+func (r *ProxyListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := configureListClient(req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *ProxyListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var filter proxyFilterModel
+	diags := req.Config.Get(ctx, &filter)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items, diags := filter.find(ctx, r.client)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, r.client, items, func(x *peekaping.Proxy) (string, string) { return x.Host, x.ID }, func(x *peekaping.Proxy) any {
+		var state proxyResourceModel
+		setModelFromProxy(&state, x)
+		state.Password = types.StringNull()
+		return &state
+	})
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
+)
+
+// listResults streams items as list results carrying their identity and, when requested,
+// their resource state. At most req.Limit results are streamed.
+func listResults[T any](ctx context.Context, req list.ListRequest, client *peekaping.Client, items []T, key func(*T) (name, id string), state func(*T) any) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		for i := range items {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+			name, id := key(&items[i])
+			result := req.NewListResult(ctx)
+			result.DisplayName = name
+			result.Diagnostics.Append(setIdentity(ctx, result.Identity, client, id)...)
+			if req.IncludeResource {
+				result.Diagnostics.Append(result.Resource.Set(ctx, state(&items[i]))...)
+			}
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// configureListClient extracts the provider client for a list resource.
+func configureListClient(req resource.ConfigureRequest, resp *resource.ConfigureResponse) *peekaping.Client {
+	if req.ProviderData == nil {
+		return nil
+	}
+	client, ok := req.ProviderData.(*peekaping.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *peekaping.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return nil
+	}
+	return client
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
+)
+
+// TestTagListResource tests listing tags across pages with a filter, a limit and
+// resource state.
+func TestTagListResource(t *testing.T) {
+	ctx := context.Background()
+	// 150 tags over two pages, every third one named "prod-*"
+	tags := make([]peekaping.Tag, 150)
+	for i := range tags {
+		name := fmt.Sprint("dev-", i)
+		if i%3 == 0 {
+			name = fmt.Sprint("prod-", i)
+		}
+		tags[i] = peekaping.Tag{ID: fmt.Sprint("tag-", i), Name: name, Color: "#ff0000"}
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		start := min(page*limit, len(tags))
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": tags[start:min(start+limit, len(tags))]})
+	}))
+	t.Cleanup(srv.Close)
+	r := &TagListResource{client: peekaping.New(srv.URL)}
+
+	var configSchema list.ListResourceSchemaResponse
+	r.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &configSchema)
+	var resourceSchema resource.SchemaResponse
+	NewTagResource().Schema(ctx, resource.SchemaRequest{}, &resourceSchema)
+
+	tests := []struct {
+		name      string
+		nameRegex any
+		limit     int64
+		wantIDs   []string
+	}{
+		{"all", nil, 0, nil},
+		{"filtered", "^prod-", 0, nil},
+		{"limited", "^prod-", 2, []string{"tag-0", "tag-3"}},
+		{"no match", "^staging-", 0, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configType := configSchema.Schema.Type().TerraformType(ctx)
+			config := tftypes.NewValue(configType, map[string]tftypes.Value{
+				"name_regex": tftypes.NewValue(tftypes.String, tt.nameRegex),
+			})
+			stream := &list.ListResultsStream{}
+			r.List(ctx, list.ListRequest{
+				Config:                 tfsdk.Config{Schema: configSchema.Schema, Raw: config},
+				IncludeResource:        true,
+				Limit:                  tt.limit,
+				ResourceSchema:         resourceSchema.Schema,
+				ResourceIdentitySchema: resourceIdentitySchema(),
+			}, stream)
+
+			want := tt.wantIDs
+			if want == nil {
+				for _, tag := range tags {
+					if tt.nameRegex == nil || tag.Name[:5] == "prod-" {
+						want = append(want, tag.ID)
+					}
+				}
+			}
+			var got []string
+			for result := range stream.Results {
+				if result.Diagnostics.HasError() {
+					t.Fatalf("list: %v", result.Diagnostics)
+				}
+				var identity resourceIdentityModel
+				result.Identity.Get(ctx, &identity)
+				var state tagResourceModel
+				result.Resource.Get(ctx, &state)
+				if state.ID != identity.ID || state.Name.ValueString() != result.DisplayName || state.Color.ValueString() != "#ff0000" {
+					t.Errorf("result %s has identity %s and state %v", result.DisplayName, identity.ID, state)
+				}
+				if identity.Endpoint.ValueString() != srv.URL {
+					t.Errorf("result %s has endpoint %s", result.DisplayName, identity.Endpoint)
+				}
+				got = append(got, identity.ID.ValueString())
+			}
+			if fmt.Sprint(got) != fmt.Sprint(want) {
+				t.Errorf("listed %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
+)
+
+var _ list.ListResourceWithConfigure = &StatusPageListResource{}
+
+type StatusPageListResource struct {
+	client *peekaping.Client
+}
+
+func NewStatusPageListResource() list.ListResource { return &StatusPageListResource{} }
+
+func (r *StatusPageListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status_page"
+}
+
+func (r *StatusPageListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists status pages for terraform query, optionally filtered. Passwords are never listed.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{Optional: true, Description: "Only list status pages whose title matches this regular expression"},
+			"published":  schema.BoolAttribute{Optional: true, Description: "Only list published (true) or unpublished (false) status pages"},
+			"monitor_id": schema.StringAttribute{Optional: true, Description: "Only list status pages showing this monitor"},
+		},
+	}
+}
+
+func (r *StatusPageListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := configureListClient(req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *StatusPageListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var filter statusPageFilterModel
+	diags := req.Config.Get(ctx, &filter)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items, diags := filter.find(ctx, r.client)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, r.client, items, func(x *peekaping.StatusPage) (string, string) { return x.Title, x.ID }, func(x *peekaping.StatusPage) any {
+		var state statusPageResourceModel
+		setModelFromStatusPageWithState(&state, x, nil)
+		state.DNSRecords = statusPageDNSRecords(toStrSliceFromStringSlice(state.Domains), statusPageDNSTarget(types.StringNull(), r.client.Endpoint))
+		state.Password = types.StringNull()
+		return &state
+	})
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
+)
+
+// resolveMaintenanceMonitors returns the sorted union of monitorIDs, the monitors carrying
+// any of tagIDs and the monitors shown on any of statusPageIDs.
+func resolveMaintenanceMonitors(ctx context.Context, client *peekaping.Client, monitorIDs, tagIDs, statusPageIDs []string) ([]string, error) {
+	ids := append([]string{}, monitorIDs...)
+	if len(tagIDs) > 0 {
+		monitors, err := client.ListMonitors(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("list monitors: %w", err)
+		}
+		ids = append(ids, monitorsWithTags(monitors.Items, tagIDs)...)
+	}
+	for _, id := range statusPageIDs {
+		sp, err := client.GetStatusPage(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("status page %s: %w", id, err)
+		}
+		ids = append(ids, sp.MonitorIDs...)
+	}
+	slices.Sort(ids)
+	return slices.Compact(ids), nil
+}
+
+// monitorsWithTags returns the IDs of the monitors carrying any of tagIDs.
+func monitorsWithTags(monitors []peekaping.Monitor, tagIDs []string) []string {
+	var ids []string
+	for i := range monitors {
+		if slices.ContainsFunc(monitorTagsOf(&monitors[i]), func(t peekaping.MonitorTag) bool {
+			return slices.Contains(tagIDs, t.TagID)
+		}) {
+			ids = append(ids, monitors[i].ID)
+		}
+	}
+	return ids
+}
+
+// knownStrings returns the elements of a string list or set, and false if the collection
+// or any element is unknown.
+func knownStrings(elems []attr.Value, unknown bool) ([]string, bool) {
+	if unknown {
+		return nil, false
+	}
+	out := make([]string, 0, len(elems))
+	for _, e := range elems {
+		s, ok := e.(types.String)
+		if !ok || s.IsUnknown() {
+			return nil, false
+		}
+		if !s.IsNull() {
+			out = append(out, s.ValueString())
+		}
+	}
+	return out, true
+}
+
+// toStringSet converts a string slice from the API into a Terraform set.
+func toStringSet(xs []string) types.Set {
+	ids := make([]attr.Value, 0, len(xs))
+	for _, x := range xs {
+		ids = append(ids, types.StringValue(x))
+	}
+	return types.SetValueMust(types.StringType, ids)
+}
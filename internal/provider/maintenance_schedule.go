@@ -0,0 +1,368 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	// Embed the IANA database so timezones validate the same on every platform.
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
+)
+
+// maintenanceNextWindowCount is the number of upcoming windows exported as next_windows.
+const maintenanceNextWindowCount = 5
+
+// maintenanceWindow is one occurrence of a maintenance schedule.
+type maintenanceWindow struct {
+	Start, End time.Time
+}
+
+// maintenanceSchedule holds the scheduling fields of a maintenance window. Start and
+// End are the optional date range bounding the schedule and are zero when unset.
+// Without a Start, recurring-interval days are counted from Anchor, or from the time
+// windows are listed from if that is zero too.
+type maintenanceSchedule struct {
+	Strategy    string
+	Cron        string
+	Location    *time.Location
+	Duration    time.Duration
+	Weekdays    []int
+	DaysOfMonth []int
+	IntervalDay int
+	StartTime   string
+	EndTime     string
+	Start, End  time.Time
+	Anchor      time.Time
+}
+
+// nextWindows returns up to count windows, in order, that end after from. A window in
+// effect at from is included.
+func (s maintenanceSchedule) nextWindows(from time.Time, count int) ([]maintenanceWindow, error) {
+	loc := s.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	from = from.In(loc)
+
+	switch s.Strategy {
+	case "manual":
+		return nil, nil
+	case "once":
+		if s.Start.IsZero() {
+			return nil, fmt.Errorf("%s maintenance requires a start date-time", s.Strategy)
+		}
+		end := s.End
+		if end.IsZero() {
+			end = s.Start.Add(s.Duration)
+		}
+		if !end.After(from) {
+			return nil, nil
+		}
+		return []maintenanceWindow{{Start: s.Start.In(loc), End: end.In(loc)}}, nil
+	}
+
+	duration, err := s.windowDuration()
+	if err != nil {
+		return nil, err
+	}
+
+	var next func(time.Time) time.Time
+	if s.Strategy == "recurring-interval" {
+		next, err = s.intervalNext(loc, from)
+	} else {
+		var cron *cronSchedule
+		cron, err = s.cron()
+		if cron != nil {
+			next = cron.next
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var windows []maintenanceWindow
+	t := from.Add(-duration)
+	if !s.Start.IsZero() && s.Start.After(t) {
+		t = s.Start.In(loc).Add(-time.Minute)
+	}
+	for len(windows) < count {
+		t = next(t)
+		if t.IsZero() || (!s.End.IsZero() && t.After(s.End)) {
+			break
+		}
+		windows = append(windows, maintenanceWindow{Start: t, End: t.Add(duration)})
+	}
+	return windows, nil
+}
+
+// status derives the status of a maintenance at now: inactive when paused,
+// under-maintenance during a window (always for active manual maintenance), scheduled
+// before the next window and ended when none is left.
+func (s maintenanceSchedule) status(active bool, now time.Time) (string, error) {
+	if !active {
+		return "inactive", nil
+	}
+	if s.Strategy == "manual" {
+		return "under-maintenance", nil
+	}
+	windows, err := s.nextWindows(now, 1)
+	switch {
+	case err != nil:
+		return "", err
+	case len(windows) == 0:
+		return "ended", nil
+	case !windows[0].Start.After(now):
+		return "under-maintenance", nil
+	default:
+		return "scheduled", nil
+	}
+}
+
+// cron returns the cron expression of a cron-driven strategy. Weekday and day-of-month
+// strategies fall back to deriving it from their fields when no cron is given.
+func (s maintenanceSchedule) cron() (*cronSchedule, error) {
+	expr := s.Cron
+	if expr == "" {
+		if s.Strategy == "cron" {
+			return nil, fmt.Errorf("cron maintenance requires a cron expression")
+		}
+		hour, minute, err := parseClock(s.StartTime)
+		if err != nil {
+			return nil, fmt.Errorf("%s maintenance requires start_time: %w", s.Strategy, err)
+		}
+		switch {
+		case len(s.Weekdays) > 0 && s.Strategy == "recurring-weekday":
+			expr = fmt.Sprintf("%d %d * * %s", minute, hour, joinInts(s.Weekdays))
+		case len(s.DaysOfMonth) > 0 && s.Strategy == "recurring-day-of-month":
+			expr = fmt.Sprintf("%d %d %s * *", minute, hour, joinInts(s.DaysOfMonth))
+		default:
+			return nil, fmt.Errorf("cannot schedule %s maintenance without a cron expression, weekdays or days of month", s.Strategy)
+		}
+	}
+	return parseCron(expr)
+}
+
+// intervalNext returns a next function for windows every IntervalDay days at StartTime,
+// counted from the start date, the anchor or from's date, whichever is set first.
+func (s maintenanceSchedule) intervalNext(loc *time.Location, from time.Time) (func(time.Time) time.Time, error) {
+	if s.IntervalDay < 1 {
+		return nil, fmt.Errorf("%s maintenance requires interval_day of at least 1", s.Strategy)
+	}
+	hour, minute, err := parseClock(s.StartTime)
+	if err != nil {
+		return nil, fmt.Errorf("%s maintenance requires start_time: %w", s.Strategy, err)
+	}
+	anchor := s.Start.In(loc)
+	switch {
+	case !s.Start.IsZero():
+	case !s.Anchor.IsZero():
+		anchor = s.Anchor.In(loc)
+	default:
+		anchor = from
+	}
+	y, m, d := anchor.Date()
+
+	return func(t time.Time) time.Time {
+		days := 0
+		if t.After(anchor) {
+			days = int(t.Sub(time.Date(y, m, d, 0, 0, 0, 0, loc)).Hours()/24) / s.IntervalDay * s.IntervalDay
+		}
+		for {
+			c := time.Date(y, m, d+days, hour, minute, 0, 0, loc)
+			if c.After(t) {
+				return c
+			}
+			days += s.IntervalDay
+		}
+	}, nil
+}
+
+// windowDuration is the window length from start_time/end_time (wrapping past
+// midnight) or, failing that, Duration.
+func (s maintenanceSchedule) windowDuration() (time.Duration, error) {
+	if s.StartTime != "" && s.EndTime != "" {
+		sh, sm, err := parseClock(s.StartTime)
+		if err != nil {
+			return 0, err
+		}
+		eh, em, err := parseClock(s.EndTime)
+		if err != nil {
+			return 0, err
+		}
+		d := time.Duration((eh*60+em)-(sh*60+sm)) * time.Minute
+		if d <= 0 {
+			d += 24 * time.Hour
+		}
+		return d, nil
+	}
+	if s.Duration <= 0 {
+		return 0, fmt.Errorf("%s maintenance requires a positive duration", s.Strategy)
+	}
+	return s.Duration, nil
+}
+
+// parseClock parses an HH:MM time of day.
+func parseClock(s string) (hour, minute int, err error) {
+	h, m, ok := strings.Cut(s, ":")
+	if ok {
+		hour, err = strconv.Atoi(h)
+		if err == nil {
+			minute, err = strconv.Atoi(m)
+		}
+	}
+	if !ok || err != nil || hour < 0 || hour > 23 || minute < 0 || minute > 59 {
+		return 0, 0, fmt.Errorf("invalid time of day %q, expected HH:MM", s)
+	}
+	return hour, minute, nil
+}
+
+// timezoneRegions are the IANA areas tried for timezones given without one.
+var timezoneRegions = []string{"Europe", "America", "Asia", "Africa", "Australia", "Pacific", "Atlantic", "Indian", "Antarctica"}
+
+// checkTimezone reports whether tz is an IANA timezone name, suggesting a near miss.
+func checkTimezone(tz string) error {
+	if tz != "Local" && tz != "" {
+		if _, err := time.LoadLocation(tz); err == nil {
+			return nil
+		}
+	}
+	if s := timezoneSuggestion(tz); s != "" {
+		return fmt.Errorf("unknown timezone %q, did you mean %q?", tz, s)
+	}
+	return fmt.Errorf("unknown timezone %q, expected an IANA name such as \"Europe/Berlin\" or \"UTC\"", tz)
+}
+
+// timezoneSuggestion returns a valid IANA name close to tz, or "" if none is found. It
+// fixes case, spaces and a missing area, e.g. "new york" becomes "America/New_York".
+func timezoneSuggestion(tz string) string {
+	name := strings.ReplaceAll(strings.TrimSpace(tz), " ", "_")
+	if name == "" {
+		return ""
+	}
+	candidates := []string{strings.ToUpper(name), titleTimezone(name)}
+	if !strings.Contains(name, "/") {
+		for _, region := range timezoneRegions {
+			candidates = append(candidates, region+"/"+titleTimezone(name))
+		}
+	}
+	for _, c := range candidates {
+		if c == tz || c == "LOCAL" {
+			continue
+		}
+		if _, err := time.LoadLocation(c); err == nil {
+			return c
+		}
+	}
+	return ""
+}
+
+var timezoneWord = regexp.MustCompile(`(^|[/_-])[a-z]+`)
+
+// titleTimezone capitalizes the words of a timezone name the way the IANA database does,
+// e.g. "america/port_of_spain" becomes "America/Port_of_Spain".
+func titleTimezone(name string) string {
+	return timezoneWord.ReplaceAllStringFunc(strings.ToLower(name), func(w string) string {
+		sep, word := "", w
+		if strings.ContainsAny(w[:1], "/_-") {
+			sep, word = w[:1], w[1:]
+		}
+		if (sep == "_" || sep == "-") && (word == "of" || word == "au" || word == "es") {
+			return w
+		}
+		return sep + strings.ToUpper(word[:1]) + word[1:]
+	})
+}
+
+func joinInts(xs []int) string {
+	xs = slices.Sorted(slices.Values(xs))
+	parts := make([]string, len(xs))
+	for i, x := range xs {
+		parts[i] = strconv.Itoa(x)
+	}
+	return strings.Join(parts, ",")
+}
+
+var maintenanceWindowAttrTypes = map[string]attr.Type{
+	"start": types.StringType,
+	"end":   types.StringType,
+}
+
+// scheduleFromMaintenance returns the schedule of m. It fails for unknown timezones.
+func scheduleFromMaintenance(m *peekaping.Maintenance) (maintenanceSchedule, error) {
+	s := maintenanceSchedule{
+		Strategy:    m.Strategy,
+		Cron:        m.Cron,
+		Location:    time.UTC,
+		Duration:    time.Duration(m.Duration) * time.Minute,
+		Weekdays:    m.Weekdays,
+		DaysOfMonth: m.DaysOfMonth,
+		IntervalDay: m.IntervalDay,
+		StartTime:   m.StartTime,
+		EndTime:     m.EndTime,
+	}
+	if m.Timezone != "" {
+		loc, err := time.LoadLocation(m.Timezone)
+		if err != nil {
+			return s, err
+		}
+		s.Location = loc
+	}
+	if t, err := time.Parse(time.RFC3339, normalizeDateTime(m.StartDateTime, m.Timezone)); err == nil {
+		s.Start = t
+	}
+	if t, err := time.Parse(time.RFC3339, normalizeDateTime(m.EndDateTime, m.Timezone)); err == nil {
+		s.End = t
+	}
+	// Counting intervals from creation keeps next_windows the same across refreshes
+	if t, err := peekaping.ParseServerTime(m.CreatedAt, time.UTC); err == nil {
+		s.Anchor = t
+	}
+	return s, nil
+}
+
+// maintenanceComputed returns the status and next_windows values of m at now. A status
+// reported by the server takes precedence; values that can't be computed are null.
+func maintenanceComputed(m *peekaping.Maintenance, now time.Time) (types.String, types.List) {
+	status := types.StringNull()
+	if m.Status != "" {
+		status = types.StringValue(m.Status)
+	}
+	windows := types.ListNull(types.ObjectType{AttrTypes: maintenanceWindowAttrTypes})
+
+	s, err := scheduleFromMaintenance(m)
+	if err != nil {
+		return status, windows
+	}
+	if status.IsNull() {
+		if v, err := s.status(m.Active, now); err == nil {
+			status = types.StringValue(v)
+		}
+	}
+	if next, err := s.nextWindows(now, maintenanceNextWindowCount); err == nil {
+		windows = maintenanceWindowsToList(next)
+	}
+	return status, windows
+}
+
+// maintenanceWindowsToList converts windows to a list of {start, end} RFC 3339 objects.
+func maintenanceWindowsToList(windows []maintenanceWindow) types.List {
+	elemType := types.ObjectType{AttrTypes: maintenanceWindowAttrTypes}
+	elems := make([]attr.Value, 0, len(windows))
+	for _, w := range windows {
+		elems = append(elems, types.ObjectValueMust(maintenanceWindowAttrTypes, map[string]attr.Value{
+			"start": types.StringValue(w.Start.Format(time.RFC3339)),
+			"end":   types.StringValue(w.End.Format(time.RFC3339)),
+		}))
+	}
+	return types.ListValueMust(elemType, elems)
+}
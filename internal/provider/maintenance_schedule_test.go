@@ -0,0 +1,403 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
+)
+
+// TestParseCron tests cron parsing errors and next-time computation.
+func TestParseCron(t *testing.T) {
+	for expr, wantErr := range map[string]string{
+		"99 * * * *":      "minute value 99 is out of range 0-59",
+		"* * * *":         "must have 5 fields",
+		"0 0 * FOO *":     `invalid value "FOO" in month field`,
+		"0 0 5-1 * *":     "start is after end",
+		"0 0 * * FRI-MON": "start is after end",
+		"0 0 * * 6-1":     "start is after end",
+		"*/0 * * * *":     "invalid step",
+		"@fortnightly":    "unknown cron macro",
+	} {
+		if _, err := parseCron(expr); err == nil || !strings.Contains(err.Error(), wantErr) {
+			t.Errorf("parseCron(%q) error = %v, want %q", expr, err, wantErr)
+		}
+	}
+
+	from := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC) // a Wednesday
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"*/15 * * * *", time.Date(2025, 1, 1, 12, 15, 0, 0, time.UTC)},
+		{"0 2 * * SAT,SUN", time.Date(2025, 1, 4, 2, 0, 0, 0, time.UTC)},
+		{"0 2 * * 7", time.Date(2025, 1, 5, 2, 0, 0, 0, time.UTC)},
+		// SUN and 0 close a range as 7
+		{"0 2 * * SAT-SUN", time.Date(2025, 1, 4, 2, 0, 0, 0, time.UTC)},
+		{"0 2 * * SUN-SUN", time.Date(2025, 1, 5, 2, 0, 0, 0, time.UTC)},
+		{"0 2 * * 6-0", time.Date(2025, 1, 4, 2, 0, 0, 0, time.UTC)},
+		{"0 2 * * 5-7", time.Date(2025, 1, 3, 2, 0, 0, 0, time.UTC)},
+		{"0 2 * * FRI-SUN,MON", time.Date(2025, 1, 3, 2, 0, 0, 0, time.UTC)},
+		{"0 2 * * MON-SUN", time.Date(2025, 1, 2, 2, 0, 0, 0, time.UTC)},
+		{"0 12 * * MON-SUN", time.Date(2025, 1, 2, 12, 0, 0, 0, time.UTC)},
+		{"30 3 1 FEB *", time.Date(2025, 2, 1, 3, 30, 0, 0, time.UTC)},
+		// restricted day-of-month and day-of-week match if either does
+		{"0 0 15 * FRI", time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 31 2 *", time.Time{}},
+	}
+	for _, tt := range tests {
+		c, err := parseCron(tt.expr)
+		if err != nil {
+			t.Fatalf("parseCron(%q): %s", tt.expr, err)
+		}
+		if got := c.next(from); !got.Equal(tt.want) {
+			t.Errorf("next(%q) = %s, want %s", tt.expr, got, tt.want)
+		}
+	}
+}
+
+// TestMaintenanceNextWindows tests window computation for each scheduling strategy.
+func TestMaintenanceNextWindows(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("timezone database unavailable")
+	}
+	from := time.Date(2025, 1, 1, 2, 30, 0, 0, time.UTC) // a Wednesday
+
+	tests := []struct {
+		name     string
+		schedule maintenanceSchedule
+		want     []string
+		wantErr  string
+	}{
+		{
+			"cron includes window in effect",
+			maintenanceSchedule{Strategy: "cron", Cron: "0 2 * * *", Duration: time.Hour},
+			[]string{"2025-01-01T02:00:00Z/2025-01-01T03:00:00Z", "2025-01-02T02:00:00Z/2025-01-02T03:00:00Z"}, "",
+		},
+		{
+			"cron in timezone",
+			maintenanceSchedule{Strategy: "cron", Cron: "0 4 * * *", Location: berlin, Duration: time.Hour},
+			[]string{"2025-01-01T04:00:00+01:00/2025-01-01T05:00:00+01:00", "2025-01-02T04:00:00+01:00/2025-01-02T05:00:00+01:00"}, "",
+		},
+		{
+			"weekday derived from fields",
+			maintenanceSchedule{Strategy: "recurring-weekday", Weekdays: []int{6, 0}, StartTime: "22:00", EndTime: "02:00"},
+			[]string{"2025-01-04T22:00:00Z/2025-01-05T02:00:00Z", "2025-01-05T22:00:00Z/2025-01-06T02:00:00Z"}, "",
+		},
+		{
+			"interval from start date",
+			maintenanceSchedule{
+				Strategy: "recurring-interval", IntervalDay: 3, StartTime: "01:00", Duration: 30 * time.Minute,
+				Start: time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC),
+			},
+			[]string{"2025-01-02T01:00:00Z/2025-01-02T01:30:00Z", "2025-01-05T01:00:00Z/2025-01-05T01:30:00Z"}, "",
+		},
+		{
+			"interval from anchor without start date",
+			maintenanceSchedule{
+				Strategy: "recurring-interval", IntervalDay: 2, StartTime: "01:00", EndTime: "02:00",
+				Anchor: time.Date(2024, 12, 31, 9, 15, 0, 0, time.UTC),
+			},
+			[]string{"2025-01-02T01:00:00Z/2025-01-02T02:00:00Z", "2025-01-04T01:00:00Z/2025-01-04T02:00:00Z"}, "",
+		},
+		{
+			"bounded by end date",
+			maintenanceSchedule{Strategy: "cron", Cron: "0 2 * * *", Duration: time.Hour, End: time.Date(2025, 1, 1, 23, 0, 0, 0, time.UTC)},
+			[]string{"2025-01-01T02:00:00Z/2025-01-01T03:00:00Z"}, "",
+		},
+		{
+			"once",
+			maintenanceSchedule{Strategy: "once", Start: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), Duration: 90 * time.Minute},
+			[]string{"2025-02-01T00:00:00Z/2025-02-01T01:30:00Z"}, "",
+		},
+		{"manual", maintenanceSchedule{Strategy: "manual"}, nil, ""},
+		{"cron without expression", maintenanceSchedule{Strategy: "cron", Duration: time.Hour}, nil, "requires a cron expression"},
+		{"missing duration", maintenanceSchedule{Strategy: "cron", Cron: "@daily"}, nil, "requires a positive duration"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			windows, err := tt.schedule.nextWindows(from, 2)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, w := range windows {
+				got = append(got, w.Start.Format(time.RFC3339)+"/"+w.End.Format(time.RFC3339))
+			}
+			if strings.Join(got, " ") != strings.Join(tt.want, " ") {
+				t.Errorf("windows = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestMaintenanceNextWindowsRefresh tests that next_windows read at different times
+// stays the same, so refreshes show no diff, until the first window ends.
+func TestMaintenanceNextWindowsRefresh(t *testing.T) {
+	day := func(h, m int) time.Time { return time.Date(2025, 1, 1, h, m, 0, 0, time.UTC) }
+	for _, m := range []peekaping.Maintenance{
+		{Strategy: "cron", Cron: "0 2 * * *", Duration: 60, Active: true},
+		{Strategy: "recurring-weekday", Weekdays: []int{0, 1, 2, 3, 4, 5, 6}, StartTime: "02:00", EndTime: "03:00", Active: true},
+		{Strategy: "recurring-interval", IntervalDay: 1, StartTime: "02:00", EndTime: "03:00", CreatedAt: "2024-12-01T10:00:00Z", Active: true},
+	} {
+		_, first := maintenanceComputed(&m, day(0, 5))
+		if first.IsNull() {
+			t.Errorf("%s: next_windows is null", m.Strategy)
+			continue
+		}
+		for _, now := range []time.Time{day(1, 59), day(2, 0), day(2, 30), day(2, 59)} {
+			if _, got := maintenanceComputed(&m, now); !got.Equal(first) {
+				t.Errorf("%s: next_windows changed on refresh at %s: %s, was %s", m.Strategy, now.Format("15:04"), got, first)
+			}
+		}
+		if _, got := maintenanceComputed(&m, day(3, 1)); got.Equal(first) {
+			t.Errorf("%s: next_windows unchanged after the first window ended", m.Strategy)
+		}
+	}
+}
+
+// TestCheckTimezone tests timezone validation and near-miss suggestions.
+func TestCheckTimezone(t *testing.T) {
+	tests := []struct {
+		tz   string
+		want string // error substring, empty if valid
+	}{
+		{"Europe/Berlin", ""},
+		{"UTC", ""},
+		{"America/Argentina/Buenos_Aires", ""},
+		{"europe/berlin", `did you mean "Europe/Berlin"`},
+		{"utc", `did you mean "UTC"`},
+		{"new york", `did you mean "America/New_York"`},
+		{"Berlin", `did you mean "Europe/Berlin"`},
+		{"america/port of spain", `did you mean "America/Port_of_Spain"`},
+		{"Local", "expected an IANA name"},
+		{"Mars/Olympus_Mons", "expected an IANA name"},
+	}
+	for _, tt := range tests {
+		err := checkTimezone(tt.tz)
+		if tt.want == "" {
+			if err != nil {
+				t.Errorf("checkTimezone(%q) = %s", tt.tz, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("checkTimezone(%q) = %v, want %q", tt.tz, err, tt.want)
+		}
+	}
+}
+
+// TestMaintenanceStatus tests the locally derived status and the server status override.
+func TestMaintenanceStatus(t *testing.T) {
+	now := time.Date(2025, 1, 1, 2, 30, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		m    peekaping.Maintenance
+		want string
+	}{
+		{"inactive", peekaping.Maintenance{Strategy: "cron", Cron: "0 2 * * *", Duration: 60}, "inactive"},
+		{"manual", peekaping.Maintenance{Strategy: "manual", Active: true}, "under-maintenance"},
+		{"in window", peekaping.Maintenance{Strategy: "cron", Cron: "0 2 * * *", Duration: 60, Active: true}, "under-maintenance"},
+		{"before window", peekaping.Maintenance{Strategy: "cron", Cron: "0 4 * * *", Duration: 60, Active: true}, "scheduled"},
+		{
+			"once ended", peekaping.Maintenance{
+				Strategy: "once", Active: true,
+				StartDateTime: "2024-12-31 22:00:00", EndDateTime: "2024-12-31 23:00:00",
+			},
+			"ended",
+		},
+		{"server status", peekaping.Maintenance{Strategy: "manual", Active: true, Status: "scheduled"}, "scheduled"},
+		{"unknown timezone", peekaping.Maintenance{Strategy: "manual", Active: true, Timezone: "Mars/Olympus_Mons"}, ""},
+	}
+	for _, tt := range tests {
+		status, _ := maintenanceComputed(&tt.m, now)
+		if status.ValueString() != tt.want {
+			t.Errorf("%s: status = %s, want %q", tt.name, status, tt.want)
+		}
+	}
+}
+
+// TestMaintenanceStatusRefresh tests that a status derived from the schedule is the
+// same on every refresh within a phase and only changes at window boundaries.
+func TestMaintenanceStatusRefresh(t *testing.T) {
+	m := peekaping.Maintenance{Strategy: "cron", Cron: "0 2 * * *", Duration: 60, Active: true}
+	day := func(h, min int) time.Time { return time.Date(2025, 1, 1, h, min, 0, 0, time.UTC) }
+	for _, tt := range []struct {
+		now  time.Time
+		want string
+	}{
+		{day(0, 5), "scheduled"},
+		{day(1, 59), "scheduled"},
+		{day(2, 0), "under-maintenance"},
+		{day(2, 30), "under-maintenance"},
+		{day(2, 59), "under-maintenance"},
+		{day(3, 0), "scheduled"},
+		{day(23, 0), "scheduled"},
+	} {
+		if status, _ := maintenanceComputed(&m, tt.now); status.ValueString() != tt.want {
+			t.Errorf("status at %s = %s, want %q", tt.now.Format("15:04"), status, tt.want)
+		}
+	}
+
+	// A status reported by the server doesn't depend on the clock at all.
+	m.Status = "scheduled"
+	for _, now := range []time.Time{day(0, 5), day(2, 30)} {
+		if status, _ := maintenanceComputed(&m, now); status.ValueString() != "scheduled" {
+			t.Errorf("server status at %s = %s", now.Format("15:04"), status)
+		}
+	}
+}
+
+// TestMaintenanceWindowsFunction tests the maintenance_windows function for each kind of
+// strategy and its strategy rules.
+func TestMaintenanceWindowsFunction(t *testing.T) {
+	ctx := context.Background()
+	from := types.StringValue("2025-01-01T02:30:00Z") // a Wednesday
+	two := types.Int64Value(2)
+	options := func(attrs map[string]attr.Value) types.Tuple {
+		typ := map[string]attr.Type{}
+		for k, v := range attrs {
+			typ[k] = v.Type(ctx)
+		}
+		obj := types.DynamicValue(types.ObjectValueMust(typ, attrs))
+		return types.TupleValueMust([]attr.Type{types.DynamicType}, []attr.Value{obj})
+	}
+	ints := func(xs ...int64) types.Tuple {
+		typs := make([]attr.Type, len(xs))
+		elems := make([]attr.Value, len(xs))
+		for i, x := range xs {
+			typs[i], elems[i] = types.NumberType, types.NumberValue(big.NewFloat(float64(x)))
+		}
+		return types.TupleValueMust(typs, elems)
+	}
+	noOptions := types.TupleValueMust(nil, nil)
+
+	tests := []struct {
+		name      string
+		args      []attr.Value
+		want      []string
+		wantError string
+	}{
+		{
+			"cron",
+			[]attr.Value{types.StringValue("cron"), types.StringValue("0 2 * * *"), types.StringNull(), types.Int64Value(60), from, two, noOptions},
+			[]string{"2025-01-01T02:00:00Z/2025-01-01T03:00:00Z", "2025-01-02T02:00:00Z/2025-01-02T03:00:00Z"}, "",
+		},
+		{
+			"weekday",
+			[]attr.Value{types.StringValue("recurring-weekday"), types.StringNull(), types.StringValue("Europe/Berlin"), types.Int64Null(), from, two, options(map[string]attr.Value{
+				"weekdays": ints(6), "start_time": types.StringValue("22:00"), "end_time": types.StringValue("02:00"),
+			})},
+			[]string{"2025-01-04T22:00:00+01:00/2025-01-05T02:00:00+01:00", "2025-01-11T22:00:00+01:00/2025-01-12T02:00:00+01:00"}, "",
+		},
+		{
+			"day of month",
+			[]attr.Value{types.StringValue("recurring-day-of-month"), types.StringNull(), types.StringNull(), types.Int64Null(), from, two, options(map[string]attr.Value{
+				"days_of_month": ints(1, 15), "start_time": types.StringValue("01:00"), "end_time": types.StringValue("03:00"),
+			})},
+			[]string{"2025-01-01T01:00:00Z/2025-01-01T03:00:00Z", "2025-01-15T01:00:00Z/2025-01-15T03:00:00Z"}, "",
+		},
+		{
+			"interval",
+			[]attr.Value{types.StringValue("recurring-interval"), types.StringNull(), types.StringNull(), types.Int64Null(), from, two, options(map[string]attr.Value{
+				"interval_day":    types.NumberValue(big.NewFloat(3)),
+				"start_time":      types.StringValue("01:00"),
+				"end_time":        types.StringValue("01:30"),
+				"start_date_time": types.StringValue("2024-12-30T00:00:00Z"),
+			})},
+			[]string{"2025-01-02T01:00:00Z/2025-01-02T01:30:00Z", "2025-01-05T01:00:00Z/2025-01-05T01:30:00Z"}, "",
+		},
+		{
+			"once",
+			[]attr.Value{types.StringValue("once"), types.StringNull(), types.StringNull(), types.Int64Null(), from, two, options(map[string]attr.Value{
+				"start_date_time": types.StringValue("2025-02-01T00:00:00Z"), "end_date_time": types.StringValue("2025-02-01T01:30:00Z"),
+			})},
+			[]string{"2025-02-01T00:00:00Z/2025-02-01T01:30:00Z"}, "",
+		},
+		{
+			"manual",
+			[]attr.Value{types.StringValue("manual"), types.StringNull(), types.StringNull(), types.Int64Null(), from, two, noOptions},
+			nil, "",
+		},
+		{
+			"cron on weekday strategy",
+			[]attr.Value{types.StringValue("recurring-weekday"), types.StringValue("0 2 * * 6"), types.StringNull(), types.Int64Null(), from, two, options(map[string]attr.Value{
+				"weekdays": ints(6), "start_time": types.StringValue("02:00"), "end_time": types.StringValue("04:00"),
+			})},
+			nil, `cron cannot be set when strategy is "recurring-weekday"`,
+		},
+		{
+			"weekday without weekdays",
+			[]attr.Value{types.StringValue("recurring-weekday"), types.StringNull(), types.StringNull(), types.Int64Null(), from, two, options(map[string]attr.Value{
+				"start_time": types.StringValue("02:00"), "end_time": types.StringValue("04:00"),
+			})},
+			nil, `weekdays is required when strategy is "recurring-weekday"`,
+		},
+		{
+			"weekday out of range",
+			[]attr.Value{types.StringValue("recurring-weekday"), types.StringNull(), types.StringNull(), types.Int64Null(), from, two, options(map[string]attr.Value{
+				"weekdays": ints(7), "start_time": types.StringValue("02:00"), "end_time": types.StringValue("04:00"),
+			})},
+			nil, "weekdays entries must be between 0 and 6, got 7",
+		},
+		{
+			"unknown option",
+			[]attr.Value{types.StringValue("cron"), types.StringValue("@daily"), types.StringNull(), types.Int64Value(60), from, two, options(map[string]attr.Value{
+				"interval": types.NumberValue(big.NewFloat(3)),
+			})},
+			nil, `unsupported option "interval"`,
+		},
+		{
+			"unknown strategy",
+			[]attr.Value{types.StringValue("recurring"), types.StringNull(), types.StringNull(), types.Int64Null(), from, two, noOptions},
+			nil, `strategy "recurring" is not supported`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := function.RunResponse{Result: function.NewResultData(types.ListUnknown(types.ObjectType{AttrTypes: maintenanceWindowAttrTypes}))}
+			NewMaintenanceWindowsFunction().Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(tt.args)}, &resp)
+			if tt.wantError != "" {
+				if resp.Error == nil || !strings.Contains(resp.Error.Error(), tt.wantError) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantError, resp.Error)
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %v", resp.Error)
+			}
+			var windows []struct {
+				Start string `tfsdk:"start"`
+				End   string `tfsdk:"end"`
+			}
+			if diags := resp.Result.Value().(types.List).ElementsAs(ctx, &windows, false); diags.HasError() {
+				t.Fatal(diags)
+			}
+			var got []string
+			for _, w := range windows {
+				got = append(got, w.Start+"/"+w.End)
+			}
+			if strings.Join(got, " ") != strings.Join(tt.want, " ") {
+				t.Errorf("windows = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
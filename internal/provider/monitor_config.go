@@ -0,0 +1,178 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"net"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// monitorConfigRule describes how the config of a monitor type is checked and which
+// defaults the config functions fill in. The checks only reject what the server rejects.
+type monitorConfigRule struct {
+	required []string
+	defaults map[string]any
+	check    func(cfg map[string]any) error
+}
+
+var (
+	httpMethods       = []string{"GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS"}
+	httpEncodings     = []string{"json", "form", "xml", "text"}
+	httpAuthMethods   = []string{"none", "basic", "oauth2-cc", "ntlm", "mtls"}
+	dnsResolveTypes   = []string{"A", "AAAA", "CAA", "CNAME", "MX", "NS", "PTR", "SOA", "SRV", "TXT"}
+	statusCodePattern = regexp.MustCompile(`^([1-5]XX|[1-5][0-9]{2}(-[1-5][0-9]{2})?)$`)
+)
+
+var httpConfigDefaults = map[string]any{
+	"method":               "GET",
+	"encoding":             "json",
+	"accepted_statuscodes": []any{"2XX"},
+	"authMethod":           "none",
+}
+
+// monitorConfigRules holds the per-type config rules. Types without a rule accept any
+// JSON object.
+var monitorConfigRules = map[string]monitorConfigRule{
+	"http":            {required: []string{"url"}, defaults: httpConfigDefaults, check: checkHTTPConfig},
+	"http-keyword":    {required: []string{"url", "keyword"}, defaults: httpConfigDefaults, check: checkHTTPConfig},
+	"http-json-query": {required: []string{"url"}, defaults: httpConfigDefaults, check: checkHTTPConfig},
+	"tcp":             {required: []string{"host", "port"}, check: checkHostPortConfig},
+	"ping":            {required: []string{"host"}, check: checkHostPortConfig},
+	"dns": {
+		required: []string{"host"},
+		defaults: map[string]any{"resolver_server": "1.1.1.1", "port": 53, "resolve_type": "A"},
+		check:    checkDNSConfig,
+	},
+}
+
+// validateMonitorConfig checks a decoded monitor config against the rules of its type.
+// peekaping_monitor applies it at plan time and the config functions to their result.
+func validateMonitorConfig(monitorType string, cfg map[string]any) error {
+	rule, ok := monitorConfigRules[monitorType]
+	if !ok {
+		return nil
+	}
+	for _, key := range rule.required {
+		if v, ok := cfg[key]; !ok || v == nil || v == "" {
+			return fmt.Errorf("%s monitors require %q in config", monitorType, key)
+		}
+	}
+	if rule.check != nil {
+		return rule.check(cfg)
+	}
+	return nil
+}
+
+// buildMonitorConfig fills in the defaults of monitorType, validates cfg and returns it
+// as normalized JSON.
+func buildMonitorConfig(monitorType string, cfg map[string]any) (string, error) {
+	out := maps.Clone(monitorConfigRules[monitorType].defaults)
+	if out == nil {
+		out = map[string]any{}
+	}
+	for k, v := range cfg {
+		if v != nil {
+			out[k] = v
+		}
+	}
+	if err := validateMonitorConfig(monitorType, out); err != nil {
+		return "", err
+	}
+	b, err := json.Marshal(out)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func checkHTTPConfig(cfg map[string]any) error {
+	raw, _ := cfg["url"].(string)
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("url %q must be an absolute http or https URL", raw)
+	}
+	if err := checkOneOf(cfg, "method", httpMethods); err != nil {
+		return err
+	}
+	if err := checkOneOf(cfg, "encoding", httpEncodings); err != nil {
+		return err
+	}
+	if err := checkOneOf(cfg, "authMethod", httpAuthMethods); err != nil {
+		return err
+	}
+	if codes, ok := cfg["accepted_statuscodes"]; ok {
+		list, ok := codes.([]any)
+		if !ok || len(list) == 0 {
+			return fmt.Errorf("accepted_statuscodes must be a non-empty list")
+		}
+		for _, c := range list {
+			s, ok := c.(string)
+			if !ok || !statusCodePattern.MatchString(s) {
+				return fmt.Errorf("accepted_statuscodes entry %v must look like \"2XX\", \"200\" or \"200-299\"", c)
+			}
+		}
+	}
+	return nil
+}
+
+func checkHostPortConfig(cfg map[string]any) error {
+	if host, ok := cfg["host"].(string); !ok || strings.ContainsAny(host, "/ ") {
+		return fmt.Errorf("host %v must be a hostname or IP address", cfg["host"])
+	}
+	return checkPort(cfg)
+}
+
+func checkDNSConfig(cfg map[string]any) error {
+	if err := checkHostPortConfig(cfg); err != nil {
+		return err
+	}
+	if s, ok := cfg["resolver_server"]; ok {
+		if str, _ := s.(string); net.ParseIP(str) == nil {
+			return fmt.Errorf("resolver_server %v must be an IP address", s)
+		}
+	}
+	return checkOneOf(cfg, "resolve_type", dnsResolveTypes)
+}
+
+// checkPort validates cfg["port"], if set, as a TCP/UDP port number.
+func checkPort(cfg map[string]any) error {
+	v, ok := cfg["port"]
+	if !ok {
+		return nil
+	}
+	var port float64
+	switch p := v.(type) {
+	case float64:
+		port = p
+	case int:
+		port = float64(p)
+	case int64:
+		port = float64(p)
+	default:
+		return fmt.Errorf("port %v must be a number", v)
+	}
+	if port != math.Trunc(port) || port < 1 || port > 65535 {
+		return fmt.Errorf("port %v must be between 1 and 65535", v)
+	}
+	return nil
+}
+
+// checkOneOf validates cfg[key], if set, against the allowed values.
+func checkOneOf(cfg map[string]any, key string, allowed []string) error {
+	v, ok := cfg[key]
+	if !ok {
+		return nil
+	}
+	if s, _ := v.(string); !slices.Contains(allowed, s) {
+		return fmt.Errorf("%s %v must be one of: %s", key, v, strings.Join(allowed, ", "))
+	}
+	return nil
+}
@@ -7,7 +7,11 @@ import (
 	"context"
 	"os"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -18,6 +22,10 @@ import (
 )
 
 var _ provider.Provider = &PeekapingProvider{}
+var _ provider.ProviderWithListResources = &PeekapingProvider{}
+var _ provider.ProviderWithFunctions = &PeekapingProvider{}
+var _ provider.ProviderWithEphemeralResources = &PeekapingProvider{}
+var _ provider.ProviderWithActions = &PeekapingProvider{}
 
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
@@ -123,6 +131,9 @@ func (p *PeekapingProvider) Configure(ctx context.Context, req provider.Configur
 	p.client = client
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.ListResourceData = client
+	resp.EphemeralResourceData = client
+	resp.ActionData = client
 }
 
 func (p *PeekapingProvider) Resources(_ context.Context) []func() resource.Resource {
@@ -133,6 +144,8 @@ func (p *PeekapingProvider) Resources(_ context.Context) []func() resource.Resou
 		NewMaintenanceResource,
 		NewStatusPageResource,
 		NewProxyResource,
+		NewMonitorNotificationResource,
+		NewMonitorTagResource,
 	}
 }
 
@@ -144,5 +157,97 @@ func (p *PeekapingProvider) DataSources(_ context.Context) []func() datasource.D
 		NewMaintenanceDataSource,
 		NewStatusPageDataSource,
 		NewProxyDataSource,
+		NewMonitorHeartbeatsDataSource,
+		NewMonitorStatsDataSource,
+		NewMonitorsDataSource,
+		NewNotificationsDataSource,
+		NewTagsDataSource,
+		NewMaintenancesDataSource,
+		NewStatusPagesDataSource,
+		NewProxiesDataSource,
+	}
+}
+
+func (p *PeekapingProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewAccessTokenEphemeralResource,
+	}
+}
+
+func (p *PeekapingProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewMonitorPauseAction,
+		NewMonitorResumeAction,
+		NewNotificationTestAction,
+	}
+}
+
+func (p *PeekapingProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewMonitorListResource,
+		NewNotificationListResource,
+		NewTagListResource,
+		NewMaintenanceListResource,
+		NewStatusPageListResource,
+		NewProxyListResource,
+	}
+}
+
+func (p *PeekapingProvider) Functions(_ context.Context) []func() function.Function {
+	url := monitorConfigArg{key: "url", description: "URL to check, http or https"}
+	host := monitorConfigArg{key: "host", description: "Hostname or IP address to check"}
+	return []func() function.Function{
+		newMonitorConfigFunction(monitorConfigFunction{
+			name:        "http_config",
+			monitorType: "http",
+			summary:     "Build the config of an http monitor",
+			description: "Returns the JSON config of an http monitor that checks url, defaulting to a GET request " +
+				"with JSON encoding, no authentication and 2XX status codes accepted.",
+			args:    []monitorConfigArg{url},
+			options: `Further request fields such as method, headers, body, authMethod or accepted_statuscodes, e.g. { method = "POST", body = "{}" }. Null fields are omitted`,
+		}),
+		newMonitorConfigFunction(monitorConfigFunction{
+			name:        "http_keyword_config",
+			monitorType: "http-keyword",
+			summary:     "Build the config of an http-keyword monitor",
+			description: "Returns the JSON config of an http-keyword monitor that fetches url and is up while the " +
+				"response body contains keyword. Request defaults are those of http_config.",
+			args:    []monitorConfigArg{url, {key: "keyword", description: "Keyword the response body must contain"}},
+			options: `Further fields such as invert_keyword or the request fields of http_config, e.g. { invert_keyword = true }. Null fields are omitted`,
+		}),
+		newMonitorConfigFunction(monitorConfigFunction{
+			name:        "http_json_query_config",
+			monitorType: "http-json-query",
+			summary:     "Build the config of an http-json-query monitor",
+			description: "Returns the JSON config of an http-json-query monitor that fetches url and evaluates " +
+				"json_query against the JSON response. Request defaults are those of http_config.",
+			args:    []monitorConfigArg{url, {key: "json_query", description: "JSON query evaluated against the response, e.g. $.status"}},
+			options: `Further fields such as json_condition and expected_value, e.g. { json_condition = "==", expected_value = "ok" }. Null fields are omitted`,
+		}),
+		newMonitorConfigFunction(monitorConfigFunction{
+			name:        "tcp_config",
+			monitorType: "tcp",
+			summary:     "Build the config of a tcp monitor",
+			description: "Returns the JSON config of a tcp monitor that is up while a TCP connection to host and port succeeds.",
+			args:        []monitorConfigArg{host, {key: "port", number: true, description: "TCP port, 1-65535"}},
+		}),
+		newMonitorConfigFunction(monitorConfigFunction{
+			name:        "ping_config",
+			monitorType: "ping",
+			summary:     "Build the config of a ping monitor",
+			description: "Returns the JSON config of a ping monitor that sends ICMP echo requests to host.",
+			args:        []monitorConfigArg{host},
+			options:     `Further fields such as packet_size, e.g. { packet_size = 56 }. Null fields are omitted`,
+		}),
+		newMonitorConfigFunction(monitorConfigFunction{
+			name:        "dns_config",
+			monitorType: "dns",
+			summary:     "Build the config of a dns monitor",
+			description: "Returns the JSON config of a dns monitor that resolves host, defaulting to an A record " +
+				"lookup against 1.1.1.1 on port 53.",
+			args:    []monitorConfigArg{{key: "host", description: "Name to resolve"}},
+			options: `Further fields such as resolver_server, port or resolve_type, e.g. { resolve_type = "MX" }. Null fields are omitted`,
+		}),
+		NewMaintenanceWindowsFunction,
 	}
 }
@@ -0,0 +1,270 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// proxyVerifyTimeout bounds a proxy reachability check, including the handshake.
+const proxyVerifyTimeout = 10 * time.Second
+
+// proxyCheck is a proxy to verify by tunnelling a connection through it to Target.
+type proxyCheck struct {
+	Protocol string
+	Host     string
+	Port     int
+	Username string
+	Password string
+	Target   string // host:port
+}
+
+// proxyVerifyTarget returns the host:port of verify_target, given as host:port or as an
+// http(s) URL whose default port is implied by the scheme.
+func proxyVerifyTarget(target string) (string, error) {
+	if !strings.Contains(target, "://") {
+		host, port, err := net.SplitHostPort(target)
+		if err != nil || host == "" {
+			return "", fmt.Errorf("%q must be host:port or an http(s) URL", target)
+		}
+		if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+			return "", fmt.Errorf("%q has an invalid port", target)
+		}
+		return target, nil
+	}
+	u, err := url.Parse(target)
+	if err != nil || u.Hostname() == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return "", fmt.Errorf("%q must be host:port or an http(s) URL", target)
+	}
+	port := u.Port()
+	if port == "" {
+		port = "80"
+		if u.Scheme == "https" {
+			port = "443"
+		}
+	}
+	return net.JoinHostPort(u.Hostname(), port), nil
+}
+
+// proxyVerifyTargetValidator validates verify_target.
+type proxyVerifyTargetValidator struct{}
+
+func (v proxyVerifyTargetValidator) Description(_ context.Context) string {
+	return "Verify target must be host:port or an http(s) URL"
+}
+
+func (v proxyVerifyTargetValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v proxyVerifyTargetValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := proxyVerifyTarget(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Verify Target", err.Error())
+	}
+}
+
+// verify connects to the proxy and asks it to open a connection to Target.
+func (p proxyCheck) verify(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, proxyVerifyTimeout)
+	defer cancel()
+
+	addr := net.JoinHostPort(p.Host, strconv.Itoa(p.Port))
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("connect to proxy %s: %w", addr, err)
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	switch p.Protocol {
+	case "http":
+		err = p.httpConnect(conn)
+	case "https":
+		tlsConn := tls.Client(conn, &tls.Config{ServerName: p.Host})
+		if err = tlsConn.HandshakeContext(ctx); err != nil {
+			return fmt.Errorf("TLS handshake with proxy %s: %w", addr, err)
+		}
+		err = p.httpConnect(tlsConn)
+	case "socks4":
+		err = p.socks4Connect(conn)
+	case "socks", "socks5", "socks5h":
+		err = p.socks5Connect(conn)
+	default:
+		return fmt.Errorf("unsupported proxy protocol %q", p.Protocol)
+	}
+	if err != nil {
+		return fmt.Errorf("proxy %s (%s) cannot reach %s: %w", addr, p.Protocol, p.Target, err)
+	}
+	return nil
+}
+
+func (p proxyCheck) httpConnect(conn net.Conn) error {
+	req := "CONNECT " + p.Target + " HTTP/1.1\r\nHost: " + p.Target + "\r\n"
+	if p.Username != "" {
+		req += "Proxy-Authorization: Basic " + base64.StdEncoding.EncodeToString([]byte(p.Username+":"+p.Password)) + "\r\n"
+	}
+	if _, err := io.WriteString(conn, req+"\r\n"); err != nil {
+		return err
+	}
+	resp, err := http.ReadResponse(bufio.NewReader(conn), &http.Request{Method: http.MethodConnect})
+	if err != nil {
+		return fmt.Errorf("read CONNECT response: %w", err)
+	}
+	resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusProxyAuthRequired:
+		return errors.New("proxy authentication failed (407)")
+	case resp.StatusCode < 200 || resp.StatusCode > 299:
+		return fmt.Errorf("CONNECT returned %s", resp.Status)
+	}
+	return nil
+}
+
+func (p proxyCheck) targetHostPort() (string, uint16, error) {
+	host, portStr, err := net.SplitHostPort(p.Target)
+	if err != nil {
+		return "", 0, err
+	}
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid target port %q", portStr)
+	}
+	return host, uint16(port), nil
+}
+
+// socks4Connect speaks SOCKS4, using SOCKS4a for hostnames.
+func (p proxyCheck) socks4Connect(conn net.Conn) error {
+	host, port, err := p.targetHostPort()
+	if err != nil {
+		return err
+	}
+	req := []byte{4, 1}
+	req = binary.BigEndian.AppendUint16(req, port)
+	ip := net.ParseIP(host).To4()
+	if ip == nil {
+		ip = net.IPv4(0, 0, 0, 1).To4()
+	}
+	req = append(req, ip...)
+	req = append(append(req, p.Username...), 0)
+	if net.ParseIP(host).To4() == nil {
+		req = append(append(req, host...), 0)
+	}
+	if _, err := conn.Write(req); err != nil {
+		return err
+	}
+	reply := make([]byte, 8)
+	if _, err := io.ReadFull(conn, reply); err != nil {
+		return fmt.Errorf("read SOCKS4 reply: %w", err)
+	}
+	if reply[1] != 0x5a {
+		return fmt.Errorf("SOCKS4 request rejected (code 0x%02x)", reply[1])
+	}
+	return nil
+}
+
+// socks5Connect speaks SOCKS5 with optional username/password authentication (RFC 1929).
+func (p proxyCheck) socks5Connect(conn net.Conn) error {
+	host, port, err := p.targetHostPort()
+	if err != nil {
+		return err
+	}
+	methods := []byte{0x00}
+	if p.Username != "" {
+		methods = []byte{0x00, 0x02}
+	}
+	if _, err := conn.Write(append([]byte{5, byte(len(methods))}, methods...)); err != nil {
+		return err
+	}
+	choice := make([]byte, 2)
+	if _, err := io.ReadFull(conn, choice); err != nil {
+		return fmt.Errorf("read SOCKS5 method: %w", err)
+	}
+	switch choice[1] {
+	case 0x00:
+	case 0x02:
+		if len(p.Username) > 255 || len(p.Password) > 255 {
+			return errors.New("SOCKS5 username and password must be at most 255 bytes")
+		}
+		auth := append([]byte{1, byte(len(p.Username))}, p.Username...)
+		auth = append(append(auth, byte(len(p.Password))), p.Password...)
+		if _, err := conn.Write(auth); err != nil {
+			return err
+		}
+		status := make([]byte, 2)
+		if _, err := io.ReadFull(conn, status); err != nil {
+			return fmt.Errorf("read SOCKS5 authentication reply: %w", err)
+		}
+		if status[1] != 0 {
+			return errors.New("SOCKS5 authentication failed")
+		}
+	default:
+		return errors.New("SOCKS5 proxy accepts none of the offered authentication methods")
+	}
+
+	req := []byte{5, 1, 0}
+	if ip := net.ParseIP(host); ip != nil && ip.To4() != nil {
+		req = append(append(req, 1), ip.To4()...)
+	} else if ip != nil {
+		req = append(append(req, 4), ip.To16()...)
+	} else if len(host) <= 255 {
+		req = append(append(req, 3, byte(len(host))), host...)
+	} else {
+		return fmt.Errorf("target host %q is too long for SOCKS5", host)
+	}
+	req = binary.BigEndian.AppendUint16(req, port)
+	if _, err := conn.Write(req); err != nil {
+		return err
+	}
+	reply := make([]byte, 4)
+	if _, err := io.ReadFull(conn, reply); err != nil {
+		return fmt.Errorf("read SOCKS5 reply: %w", err)
+	}
+	if reply[1] != 0 {
+		return fmt.Errorf("SOCKS5 connect failed: %s", socks5Reply(reply[1]))
+	}
+	return nil
+}
+
+func socks5Reply(code byte) string {
+	switch code {
+	case 1:
+		return "general failure"
+	case 2:
+		return "connection not allowed by ruleset"
+	case 3:
+		return "network unreachable"
+	case 4:
+		return "host unreachable"
+	case 5:
+		return "connection refused"
+	case 6:
+		return "TTL expired"
+	case 7:
+		return "command not supported"
+	case 8:
+		return "address type not supported"
+	}
+	return fmt.Sprintf("code 0x%02x", code)
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// stubProxy accepts one connection at a time and answers it with handle.
+func stubProxy(t *testing.T, handle func(net.Conn)) (string, int) {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			handle(conn)
+			conn.Close()
+		}
+	}()
+	host, port, _ := net.SplitHostPort(ln.Addr().String())
+	p, _ := strconv.Atoi(port)
+	return host, p
+}
+
+func TestProxyCheckHTTP(t *testing.T) {
+	host, port := stubProxy(t, func(conn net.Conn) {
+		req, err := http.ReadRequest(bufio.NewReader(conn))
+		if err != nil {
+			return
+		}
+		switch {
+		case req.Method != http.MethodConnect || req.Host != "peekaping.example.com:443":
+			io.WriteString(conn, "HTTP/1.1 400 Bad Request\r\n\r\n")
+		case req.Header.Get("Proxy-Authorization") != "Basic dXNlcjpwYXNz": // user:pass
+			io.WriteString(conn, "HTTP/1.1 407 Proxy Authentication Required\r\n\r\n")
+		default:
+			io.WriteString(conn, "HTTP/1.1 200 Connection established\r\n\r\n")
+		}
+	})
+
+	check := proxyCheck{Protocol: "http", Host: host, Port: port, Username: "user", Password: "pass", Target: "peekaping.example.com:443"}
+	if err := check.verify(context.Background()); err != nil {
+		t.Errorf("verify() error = %v", err)
+	}
+	check.Password = "wrong"
+	if err := check.verify(context.Background()); err == nil || !strings.Contains(err.Error(), "407") {
+		t.Errorf("verify() with a wrong password error = %v, want 407", err)
+	}
+}
+
+func TestProxyCheckSOCKS5(t *testing.T) {
+	host, port := stubProxy(t, func(conn net.Conn) {
+		buf := make([]byte, 512)
+		if _, err := io.ReadFull(conn, buf[:2]); err != nil {
+			return
+		}
+		io.ReadFull(conn, buf[:buf[1]])
+		conn.Write([]byte{5, 2})
+		// RFC 1929: version, username, password.
+		io.ReadFull(conn, buf[:2])
+		user := make([]byte, buf[1])
+		io.ReadFull(conn, user)
+		io.ReadFull(conn, buf[:1])
+		pass := make([]byte, buf[0])
+		io.ReadFull(conn, pass)
+		if string(user) != "user" || string(pass) != "pass" {
+			conn.Write([]byte{1, 1})
+			return
+		}
+		conn.Write([]byte{1, 0})
+		// CONNECT with a domain name.
+		io.ReadFull(conn, buf[:5])
+		name := make([]byte, buf[4])
+		io.ReadFull(conn, name)
+		io.ReadFull(conn, buf[:2])
+		code := byte(0)
+		if string(name) != "peekaping.example.com" {
+			code = 4
+		}
+		conn.Write([]byte{5, code, 0, 1, 0, 0, 0, 0, 0, 0})
+	})
+
+	check := proxyCheck{Protocol: "socks5", Host: host, Port: port, Username: "user", Password: "pass", Target: "peekaping.example.com:443"}
+	if err := check.verify(context.Background()); err != nil {
+		t.Errorf("verify() error = %v", err)
+	}
+	check.Target = "elsewhere.example.com:443"
+	if err := check.verify(context.Background()); err == nil || !strings.Contains(err.Error(), "host unreachable") {
+		t.Errorf("verify() of an unreachable target error = %v", err)
+	}
+	check.Target, check.Password = "peekaping.example.com:443", "wrong"
+	if err := check.verify(context.Background()); err == nil || !strings.Contains(err.Error(), "authentication failed") {
+		t.Errorf("verify() with a wrong password error = %v", err)
+	}
+}
+
+func TestProxyCheckUnreachable(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := ln.Addr().(*net.TCPAddr)
+	ln.Close()
+
+	check := proxyCheck{Protocol: "http", Host: "127.0.0.1", Port: addr.Port, Target: "peekaping.example.com:443"}
+	if err := check.verify(context.Background()); err == nil || !strings.Contains(err.Error(), "connect to proxy") {
+		t.Errorf("verify() of a closed port error = %v", err)
+	}
+}
+
+func TestProxyVerifyTarget(t *testing.T) {
+	for target, want := range map[string]string{
+		"https://api.example.com":       "api.example.com:443",
+		"http://api.example.com":        "api.example.com:80",
+		"http://10.0.0.5:8383":          "10.0.0.5:8383",
+		"https://api.example.com/base/": "api.example.com:443",
+		"db.internal:5432":              "db.internal:5432",
+		"[2001:db8::1]:443":             "[2001:db8::1]:443",
+	} {
+		if got, err := proxyVerifyTarget(target); err != nil || got != want {
+			t.Errorf("proxyVerifyTarget(%q) = %q, %v, want %q", target, got, err, want)
+		}
+	}
+	for _, target := range []string{"api.example.com", "api.example.com:0", "ftp://api.example.com", ":443"} {
+		if _, err := proxyVerifyTarget(target); err == nil {
+			t.Errorf("proxyVerifyTarget(%q) should fail", target)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
+)
+
+// resourceIdentityModel is the identity shared by all resources: the object ID and the
+// endpoint of the Peekaping instance that owns it.
+type resourceIdentityModel struct {
+	ID       types.String `tfsdk:"id"`
+	Endpoint types.String `tfsdk:"endpoint"`
+}
+
+func resourceIdentitySchema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Object ID",
+			},
+			"endpoint": identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       "Peekaping endpoint the object belongs to. Must match the provider endpoint when set",
+			},
+		},
+	}
+}
+
+// setIdentity records the identity of the object with the given ID. An identity that
+// already names this object and an endpoint is kept, so changing the provider endpoint
+// of an existing instance doesn't count as an identity change.
+func setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, client *peekaping.Client, id string) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+	if !identity.Raw.IsNull() {
+		var current resourceIdentityModel
+		diags := identity.Get(ctx, &current)
+		if !diags.HasError() && current.ID.ValueString() == id && current.Endpoint.ValueString() != "" {
+			return nil
+		}
+	}
+	return identity.Set(ctx, resourceIdentityModel{
+		ID:       types.StringValue(id),
+		Endpoint: types.StringValue(client.Endpoint),
+	})
+}
+
+// importIDFromRequest returns the import ID given either as a string or, on Terraform
+// 1.12+, as an identity whose endpoint (if set) must match the provider endpoint.
+func importIDFromRequest(ctx context.Context, req resource.ImportStateRequest, client *peekaping.Client) (string, diag.Diagnostics) {
+	if req.ID != "" || req.Identity == nil {
+		return req.ID, nil
+	}
+
+	var identity resourceIdentityModel
+	diags := req.Identity.Get(ctx, &identity)
+	if diags.HasError() {
+		return "", diags
+	}
+	if endpoint := identity.Endpoint.ValueString(); endpoint != "" && strings.TrimSuffix(endpoint, "/") != client.Endpoint {
+		diags.AddError("import failed", fmt.Sprintf("identity endpoint %q does not match the provider endpoint %q", endpoint, client.Endpoint))
+		return "", diags
+	}
+	return identity.ID.ValueString(), diags
+}
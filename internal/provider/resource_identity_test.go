@@ -0,0 +1,54 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
+)
+
+// TestSetIdentity tests that a recorded endpoint survives provider endpoint changes.
+func TestSetIdentity(t *testing.T) {
+	ctx := context.Background()
+	schema := resourceIdentitySchema()
+	identity := &tfsdk.ResourceIdentity{
+		Schema: schema,
+		Raw:    tftypes.NewValue(schema.Type().TerraformType(ctx), nil),
+	}
+
+	get := func() resourceIdentityModel {
+		var m resourceIdentityModel
+		if diags := identity.Get(ctx, &m); diags.HasError() {
+			t.Fatalf("get identity: %v", diags)
+		}
+		return m
+	}
+
+	old := &peekaping.Client{Endpoint: "https://old.example.com"}
+	if diags := setIdentity(ctx, identity, old, "abc"); diags.HasError() {
+		t.Fatalf("set identity: %v", diags)
+	}
+	if got := get(); got.ID.ValueString() != "abc" || got.Endpoint.ValueString() != old.Endpoint {
+		t.Fatalf("unexpected identity %v", got)
+	}
+
+	moved := &peekaping.Client{Endpoint: "https://new.example.com"}
+	setIdentity(ctx, identity, moved, "abc")
+	if got := get(); got.Endpoint.ValueString() != old.Endpoint {
+		t.Errorf("expected endpoint %s to be kept, got %s", old.Endpoint, got.Endpoint.ValueString())
+	}
+
+	// An import identity without endpoint is completed
+	identity.Set(ctx, resourceIdentityModel{ID: types.StringValue("def"), Endpoint: types.StringNull()})
+	setIdentity(ctx, identity, moved, "def")
+	if got := get(); got.Endpoint.ValueString() != moved.Endpoint {
+		t.Errorf("expected endpoint %s, got %s", moved.Endpoint, got.Endpoint.ValueString())
+	}
+}
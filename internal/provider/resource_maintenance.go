@@ -7,9 +7,13 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -23,6 +27,9 @@ import (
 
 var _ resource.Resource = &MaintenanceResource{}
 var _ resource.ResourceWithImportState = &MaintenanceResource{}
+var _ resource.ResourceWithIdentity = &MaintenanceResource{}
+var _ resource.ResourceWithValidateConfig = &MaintenanceResource{}
+var _ resource.ResourceWithModifyPlan = &MaintenanceResource{}
 
 type MaintenanceResource struct {
 	client *peekaping.Client
@@ -65,15 +72,18 @@ func (v maintenanceTitleValidator) ValidateString(ctx context.Context, req valid
 	}
 }
 
+// maintenanceStrategies are the strategies Peekaping supports.
+var maintenanceStrategies = []string{"manual", "once", "cron", "recurring-interval", "recurring-weekday", "recurring-day-of-month"}
+
 // maintenanceStrategyValidator validates maintenance strategy.
 type maintenanceStrategyValidator struct{}
 
 func (v maintenanceStrategyValidator) Description(_ context.Context) string {
-	return "Maintenance strategy must be one of: once, recurring, cron"
+	return "Maintenance strategy must be one of: " + strings.Join(maintenanceStrategies, ", ")
 }
 
 func (v maintenanceStrategyValidator) MarkdownDescription(_ context.Context) string {
-	return "Maintenance strategy must be one of: `once`, `recurring`, `cron`"
+	return "Maintenance strategy must be one of: `" + strings.Join(maintenanceStrategies, "`, `") + "`"
 }
 
 func (v maintenanceStrategyValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
@@ -82,30 +92,129 @@ func (v maintenanceStrategyValidator) ValidateString(ctx context.Context, req va
 	}
 
 	strategy := req.ConfigValue.ValueString()
-	supportedStrategies := []string{"once", "recurring", "cron"}
-
-	for _, supportedStrategy := range supportedStrategies {
-		if strategy == supportedStrategy {
-			return
-		}
+	if slices.Contains(maintenanceStrategies, strategy) {
+		return
 	}
 
 	resp.Diagnostics.AddAttributeError(
 		req.Path,
 		"Invalid Strategy",
-		fmt.Sprintf("Maintenance strategy '%s' is not supported. Supported strategies are: %s", strategy, strings.Join(supportedStrategies, ", ")),
+		fmt.Sprintf("Maintenance strategy '%s' is not supported. Supported strategies are: %s", strategy, strings.Join(maintenanceStrategies, ", ")),
 	)
 }
 
-// maintenanceCronValidator validates cron expression format.
+// maintenanceScheduleAttributes are the attributes whose use depends on the strategy.
+var maintenanceScheduleAttributes = []string{
+	"start_date_time", "end_date_time", "cron", "duration", "weekdays", "days_of_month", "interval_day", "start_time", "end_time",
+}
+
+// maintenanceDateRange are the optional attributes bounding a recurring schedule.
+var maintenanceDateRange = []string{"start_date_time", "end_date_time"}
+
+// maintenanceStrategyRule lists the schedule attributes a strategy requires and those it
+// accepts optionally. All other schedule attributes must be left unset.
+type maintenanceStrategyRule struct {
+	required, optional []string
+}
+
+var maintenanceStrategyRules = map[string]maintenanceStrategyRule{
+	"manual":                 {},
+	"once":                   {required: []string{"start_date_time", "end_date_time"}},
+	"cron":                   {required: []string{"cron", "duration"}, optional: maintenanceDateRange},
+	"recurring-interval":     {required: []string{"interval_day", "start_time", "end_time"}, optional: maintenanceDateRange},
+	"recurring-weekday":      {required: []string{"weekdays", "start_time", "end_time"}, optional: maintenanceDateRange},
+	"recurring-day-of-month": {required: []string{"days_of_month", "start_time", "end_time"}, optional: maintenanceDateRange},
+}
+
+// checkMaintenanceStrategy reports schedule attributes that strategy requires but are
+// missing, or forbids but are set. Attributes absent from values are unknown and skipped.
+func checkMaintenanceStrategy(strategy string, values map[string]attr.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+	rule, ok := maintenanceStrategyRules[strategy]
+	if !ok {
+		return diags
+	}
+	for _, name := range maintenanceScheduleAttributes {
+		v, ok := values[name]
+		if !ok || v.IsUnknown() {
+			continue
+		}
+		set := !v.IsNull()
+		if l, ok := v.(types.List); ok && set {
+			set = len(l.Elements()) > 0
+		}
+		switch {
+		case slices.Contains(rule.required, name) && !set:
+			diags.AddAttributeError(
+				path.Root(name),
+				"Missing Attribute",
+				fmt.Sprintf("%s is required when strategy is %q", name, strategy),
+			)
+		case set && !slices.Contains(rule.required, name) && !slices.Contains(rule.optional, name):
+			diags.AddAttributeError(
+				path.Root(name),
+				"Conflicting Attribute",
+				fmt.Sprintf("%s cannot be set when strategy is %q", name, strategy),
+			)
+		}
+	}
+	return diags
+}
+
+// maintenanceDayRanges are the values the server accepts in weekdays (0=Sunday) and
+// days_of_month.
+var maintenanceDayRanges = map[string][2]int{
+	"weekdays":      {0, 6},
+	"days_of_month": {1, 31},
+}
+
+// checkMaintenanceDay reports whether day is within the range of the named attribute.
+func checkMaintenanceDay(name string, day int) error {
+	r := maintenanceDayRanges[name]
+	if day < r[0] || day > r[1] {
+		return fmt.Errorf("%s entries must be between %d and %d, got %d", name, r[0], r[1], day)
+	}
+	return nil
+}
+
+// maintenanceDaysValidator validates each element of weekdays or days_of_month.
+type maintenanceDaysValidator struct {
+	name string
+}
+
+func (v maintenanceDaysValidator) Description(_ context.Context) string {
+	r := maintenanceDayRanges[v.name]
+	return fmt.Sprintf("Each entry must be between %d and %d", r[0], r[1])
+}
+
+func (v maintenanceDaysValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v maintenanceDaysValidator) ValidateList(_ context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	for i, e := range req.ConfigValue.Elements() {
+		day, ok := e.(types.Int64)
+		if !ok || day.IsNull() || day.IsUnknown() {
+			continue
+		}
+		if err := checkMaintenanceDay(v.name, int(day.ValueInt64())); err != nil {
+			resp.Diagnostics.AddAttributeError(req.Path.AtListIndex(i), "Invalid Day", err.Error())
+		}
+	}
+}
+
+// maintenanceCronValidator validates cron expressions with the parser used for next_windows.
 type maintenanceCronValidator struct{}
 
 func (v maintenanceCronValidator) Description(_ context.Context) string {
-	return "Cron expression must be a valid 5-field cron format"
+	return "Cron expression must be a valid 5-field cron expression or macro"
 }
 
 func (v maintenanceCronValidator) MarkdownDescription(_ context.Context) string {
-	return "Cron expression must be a valid 5-field cron format (minute hour day month weekday)"
+	return "Cron expression must be a valid 5-field cron expression (minute hour day month weekday) or one of `@yearly`, `@monthly`, `@weekly`, `@daily`, `@hourly`"
 }
 
 func (v maintenanceCronValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
@@ -118,16 +227,34 @@ func (v maintenanceCronValidator) ValidateString(ctx context.Context, req valida
 		return // Empty cron is allowed
 	}
 
-	// Basic cron validation - check for 5 fields separated by spaces
-	cronPattern := regexp.MustCompile(`^(\S+\s+){4}\S+$`)
-	if !cronPattern.MatchString(cron) {
+	if _, err := parseCron(cron); err != nil {
 		resp.Diagnostics.AddAttributeError(
 			req.Path,
 			"Invalid Cron Expression",
-			"Cron expression must be in 5-field format: minute hour day month weekday",
+			fmt.Sprintf("Cron expression %q is invalid: %s", cron, err),
 		)
+	}
+}
+
+// maintenanceTimezoneValidator validates timezones against the IANA database.
+type maintenanceTimezoneValidator struct{}
+
+func (v maintenanceTimezoneValidator) Description(_ context.Context) string {
+	return "Timezone must be an IANA timezone name, e.g. Europe/Berlin"
+}
+
+func (v maintenanceTimezoneValidator) MarkdownDescription(_ context.Context) string {
+	return "Timezone must be an IANA timezone name, e.g. `Europe/Berlin`"
+}
+
+func (v maintenanceTimezoneValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
 		return
 	}
+
+	if err := checkTimezone(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Timezone", err.Error())
+	}
 }
 
 // maintenanceTimeValidator validates time format (HH:MM).
@@ -191,24 +318,29 @@ func (v maintenanceDescriptionValidator) ValidateString(ctx context.Context, req
 }
 
 type maintenanceResourceModel struct {
-	ID            types.String  `tfsdk:"id"`
-	Title         types.String  `tfsdk:"title"`
-	Description   types.String  `tfsdk:"description"`
-	Strategy      types.String  `tfsdk:"strategy"`
-	Active        types.Bool    `tfsdk:"active"`
-	MonitorIDs    types.List    `tfsdk:"monitor_ids"`
-	StartDateTime types.String  `tfsdk:"start_date_time"`
-	EndDateTime   types.String  `tfsdk:"end_date_time"`
-	Duration      types.Int64   `tfsdk:"duration"`
-	Timezone      types.String  `tfsdk:"timezone"`
-	Cron          types.String  `tfsdk:"cron"`
-	Weekdays      []types.Int64 `tfsdk:"weekdays"`
-	DaysOfMonth   []types.Int64 `tfsdk:"days_of_month"`
-	IntervalDay   types.Int64   `tfsdk:"interval_day"`
-	StartTime     types.String  `tfsdk:"start_time"`
-	EndTime       types.String  `tfsdk:"end_time"`
-	CreatedAt     types.String  `tfsdk:"created_at"`
-	UpdatedAt     types.String  `tfsdk:"updated_at"`
+	ID                  types.String  `tfsdk:"id"`
+	Title               types.String  `tfsdk:"title"`
+	Description         types.String  `tfsdk:"description"`
+	Strategy            types.String  `tfsdk:"strategy"`
+	Active              types.Bool    `tfsdk:"active"`
+	MonitorIDs          types.List    `tfsdk:"monitor_ids"`
+	TagIDs              types.Set     `tfsdk:"tag_ids"`
+	StatusPageIDs       types.Set     `tfsdk:"status_page_ids"`
+	EffectiveMonitorIDs types.Set     `tfsdk:"effective_monitor_ids"`
+	StartDateTime       dateTimeValue `tfsdk:"start_date_time"`
+	EndDateTime         dateTimeValue `tfsdk:"end_date_time"`
+	Duration            types.Int64   `tfsdk:"duration"`
+	Timezone            types.String  `tfsdk:"timezone"`
+	Cron                types.String  `tfsdk:"cron"`
+	Weekdays            []types.Int64 `tfsdk:"weekdays"`
+	DaysOfMonth         []types.Int64 `tfsdk:"days_of_month"`
+	IntervalDay         types.Int64   `tfsdk:"interval_day"`
+	StartTime           types.String  `tfsdk:"start_time"`
+	EndTime             types.String  `tfsdk:"end_time"`
+	Status              types.String  `tfsdk:"status"`
+	NextWindows         types.List    `tfsdk:"next_windows"`
+	CreatedAt           types.String  `tfsdk:"created_at"`
+	UpdatedAt           types.String  `tfsdk:"updated_at"`
 }
 
 func (r *MaintenanceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -240,7 +372,7 @@ func (r *MaintenanceResource) Schema(_ context.Context, _ resource.SchemaRequest
 			},
 			"strategy": schema.StringAttribute{
 				Required:    true,
-				Description: "Maintenance strategy (once, recurring, cron)",
+				Description: "Maintenance strategy (manual, once, cron, recurring-interval, recurring-weekday, recurring-day-of-month)",
 				Validators: []validator.String{
 					maintenanceStrategyValidator{},
 				},
@@ -250,25 +382,49 @@ func (r *MaintenanceResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Description: "Whether the maintenance window is active",
 			},
 			"monitor_ids": schema.ListAttribute{
+				Optional:    true,
 				Computed:    true,
 				ElementType: types.StringType,
-				Description: "List of monitor IDs to include in maintenance",
+				Description: "Monitor IDs to include in maintenance. Without tag_ids and status_page_ids, this reflects the server when omitted",
+			},
+			"tag_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Include every monitor carrying any of these tags, re-resolved on each plan",
+			},
+			"status_page_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Include every monitor shown on any of these status pages, re-resolved on each plan",
+			},
+			"effective_monitor_ids": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "All monitors covered by the maintenance: monitor_ids plus those matched by tag_ids and status_page_ids",
 			},
 			"start_date_time": schema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
-				Description: "Start date and time (ISO 8601 format)",
+				CustomType:  dateTimeType{},
+				Description: "Start of a once window, or of the date range bounding a recurring schedule (RFC 3339)",
 			},
 			"end_date_time": schema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
-				Description: "End date and time (ISO 8601 format)",
+				CustomType:  dateTimeType{},
+				Description: "End of a once window, or of the date range bounding a recurring schedule (RFC 3339)",
 			},
 			"duration": schema.Int64Attribute{
+				Optional:    true,
 				Computed:    true,
-				Description: "Duration in minutes",
+				Description: "Window length in minutes. Required for cron strategies; recurring strategies derive it from start_time and end_time",
 			},
 			"timezone": schema.StringAttribute{
 				Optional:    true,
-				Description: "Timezone for the maintenance window",
+				Description: "IANA timezone for the maintenance window, e.g. Europe/Berlin",
+				Validators: []validator.String{
+					maintenanceTimezoneValidator{},
+				},
 			},
 			"cron": schema.StringAttribute{
 				Optional:    true,
@@ -280,12 +436,18 @@ func (r *MaintenanceResource) Schema(_ context.Context, _ resource.SchemaRequest
 			"weekdays": schema.ListAttribute{
 				Optional:    true,
 				ElementType: types.Int64Type,
-				Description: "Days of the week (0=Sunday, 1=Monday, etc.)",
+				Description: "Days of the week (0=Sunday, 1=Monday, etc., up to 6=Saturday)",
+				Validators: []validator.List{
+					maintenanceDaysValidator{name: "weekdays"},
+				},
 			},
 			"days_of_month": schema.ListAttribute{
 				Optional:    true,
 				ElementType: types.Int64Type,
 				Description: "Days of the month (1-31)",
+				Validators: []validator.List{
+					maintenanceDaysValidator{name: "days_of_month"},
+				},
 			},
 			"interval_day": schema.Int64Attribute{
 				Optional:    true,
@@ -305,6 +467,21 @@ func (r *MaintenanceResource) Schema(_ context.Context, _ resource.SchemaRequest
 					maintenanceTimeValidator{},
 				},
 			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Current status: scheduled, under-maintenance, ended or inactive. Reported by the server, or derived from the schedule when it reports none",
+			},
+			"next_windows": schema.ListNestedAttribute{
+				Computed: true,
+				Description: "Upcoming maintenance windows, computed locally from the schedule when the resource is read. " +
+					"A window in effect is included, so the list only changes when a window ends or the schedule changes",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"start": schema.StringAttribute{Computed: true, Description: "Window start (RFC 3339)"},
+						"end":   schema.StringAttribute{Computed: true, Description: "Window end (RFC 3339)"},
+					},
+				},
+			},
 			"created_at": schema.StringAttribute{
 				Computed:    true,
 				Description: "Creation timestamp",
@@ -320,6 +497,10 @@ func (r *MaintenanceResource) Schema(_ context.Context, _ resource.SchemaRequest
 	}
 }
 
+func (r *MaintenanceResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *MaintenanceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -335,6 +516,119 @@ func (r *MaintenanceResource) Configure(_ context.Context, req resource.Configur
 	r.client = client
 }
 
+func (r *MaintenanceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var strategy types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("strategy"), &strategy)...)
+	if resp.Diagnostics.HasError() || strategy.IsNull() || strategy.IsUnknown() {
+		return
+	}
+
+	values := map[string]attr.Value{}
+	for _, name := range maintenanceScheduleAttributes {
+		var v attr.Value
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &v)...)
+		values[name] = v
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkMaintenanceStrategy(strategy.ValueString(), values)...)
+
+	startValue, _ := values["start_date_time"].(dateTimeValue)
+	endValue, _ := values["end_date_time"].(dateTimeValue)
+	start, errStart := startValue.Time()
+	end, errEnd := endValue.Time()
+	if errStart == nil && errEnd == nil && !end.After(start) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("end_date_time"),
+			"Invalid Date Range",
+			fmt.Sprintf("end_date_time %s must be after start_date_time %s", end.Format(time.RFC3339), start.Format(time.RFC3339)),
+		)
+	}
+}
+
+func (r *MaintenanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	r.checkEndDateTime(ctx, req, resp)
+	r.planMonitors(ctx, req, resp)
+}
+
+// checkEndDateTime rejects a new or changed end_date_time that is in the past.
+func (r *MaintenanceResource) checkEndDateTime(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan, state dateTimeValue
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("end_date_time"), &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("end_date_time"), &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only a new or changed end is checked, so windows that have ended don't block plans.
+	if equal, _ := state.StringSemanticEquals(ctx, plan); equal && !state.IsNull() {
+		return
+	}
+	if end, err := plan.Time(); err == nil && end.Before(time.Now()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("end_date_time"),
+			"Invalid Date Range",
+			fmt.Sprintf("end_date_time %s is in the past", end.Format(time.RFC3339)),
+		)
+	}
+}
+
+// planMonitors resolves monitor_ids, tag_ids and status_page_ids into
+// effective_monitor_ids, so monitors joining a tag or status page show up as a diff.
+func (r *MaintenanceResource) planMonitors(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var monitorIDs, configMonitorIDs types.List
+	var tagIDs, statusPageIDs types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("monitor_ids"), &monitorIDs)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("monitor_ids"), &configMonitorIDs)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tag_ids"), &tagIDs)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("status_page_ids"), &statusPageIDs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// With selectors, an omitted monitor_ids means no explicitly listed monitors.
+	if (!tagIDs.IsNull() || !statusPageIDs.IsNull()) && configMonitorIDs.IsNull() {
+		monitorIDs = types.ListValueMust(types.StringType, nil)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("monitor_ids"), monitorIDs)...)
+	}
+
+	monitors, ok1 := knownStrings(monitorIDs.Elements(), monitorIDs.IsUnknown())
+	tags, ok2 := knownStrings(tagIDs.Elements(), tagIDs.IsUnknown())
+	pages, ok3 := knownStrings(statusPageIDs.Elements(), statusPageIDs.IsUnknown())
+	if !ok1 || !ok2 || !ok3 || r.client == nil {
+		return // resolved at apply time
+	}
+	ids, err := resolveMaintenanceMonitors(ctx, r.client, monitors, tags, pages)
+	if err != nil {
+		resp.Diagnostics.AddError("resolve maintenance monitors failed", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("effective_monitor_ids"), toStringSet(ids))...)
+}
+
+// effectiveMonitorIDs returns the monitors to send to the server, resolving the
+// selectors now if the plan couldn't. It returns false when monitors aren't managed, i.e.
+// neither monitor_ids nor a selector is set.
+func (r *MaintenanceResource) effectiveMonitorIDs(ctx context.Context, plan *maintenanceResourceModel) ([]string, bool, error) {
+	if ids, ok := knownStrings(plan.EffectiveMonitorIDs.Elements(), plan.EffectiveMonitorIDs.IsUnknown()); ok && !plan.EffectiveMonitorIDs.IsNull() {
+		return ids, true, nil
+	}
+	if plan.MonitorIDs.IsUnknown() && plan.TagIDs.IsNull() && plan.StatusPageIDs.IsNull() {
+		return nil, false, nil
+	}
+	monitors, _ := knownStrings(plan.MonitorIDs.Elements(), false)
+	tags, _ := knownStrings(plan.TagIDs.Elements(), false)
+	pages, _ := knownStrings(plan.StatusPageIDs.Elements(), false)
+	ids, err := resolveMaintenanceMonitors(ctx, r.client, monitors, tags, pages)
+	return ids, true, err
+}
+
 func (r *MaintenanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan maintenanceResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -351,16 +645,28 @@ func (r *MaintenanceResource) Create(ctx context.Context, req resource.CreateReq
 	})
 
 	in := peekaping.MaintenanceCreate{
-		Title:       plan.Title.ValueString(),
-		Description: plan.Description.ValueString(),
-		Strategy:    plan.Strategy.ValueString(),
-		Timezone:    plan.Timezone.ValueString(),
-		Cron:        plan.Cron.ValueString(),
-		Weekdays:    toIntSlice(plan.Weekdays),
-		DaysOfMonth: toIntSlice(plan.DaysOfMonth),
-		IntervalDay: int(plan.IntervalDay.ValueInt64()),
-		StartTime:   plan.StartTime.ValueString(),
-		EndTime:     plan.EndTime.ValueString(),
+		Title:         plan.Title.ValueString(),
+		Description:   plan.Description.ValueString(),
+		Strategy:      plan.Strategy.ValueString(),
+		StartDateTime: plan.StartDateTime.ValueString(),
+		EndDateTime:   plan.EndDateTime.ValueString(),
+		Duration:      int(plan.Duration.ValueInt64()),
+		Timezone:      plan.Timezone.ValueString(),
+		Cron:          plan.Cron.ValueString(),
+		Weekdays:      toIntSlice(plan.Weekdays),
+		DaysOfMonth:   toIntSlice(plan.DaysOfMonth),
+		IntervalDay:   int(plan.IntervalDay.ValueInt64()),
+		StartTime:     plan.StartTime.ValueString(),
+		EndTime:       plan.EndTime.ValueString(),
+	}
+
+	ids, managed, err := r.effectiveMonitorIDs(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("resolve maintenance monitors failed", err.Error())
+		return
+	}
+	if managed {
+		in.MonitorIDs = ids
 	}
 
 	m, err := r.client.CreateMaintenance(ctx, in)
@@ -369,8 +675,8 @@ func (r *MaintenanceResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 	setModelFromMaintenanceWithState(&plan, m)
-	// Preserve the plan's monitor_ids and duration to maintain Terraform state consistency
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, plan.ID.ValueString())...)
 }
 
 func (r *MaintenanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -387,6 +693,7 @@ func (r *MaintenanceResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 	setModelFromMaintenance(&state, m)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, state.ID.ValueString())...)
 }
 
 func (r *MaintenanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -411,39 +718,42 @@ func (r *MaintenanceResource) Update(ctx context.Context, req resource.UpdateReq
 		v := plan.Title.ValueString()
 		upd.Title = &v
 	}
-	if !plan.Description.IsNull() {
-		v := plan.Description.ValueString()
-		upd.Description = &v
-	}
+	upd.Description = patchString(plan.Description, state.Description)
 	if !plan.Strategy.IsNull() {
 		v := plan.Strategy.ValueString()
 		upd.Strategy = &v
 	}
+	if !plan.StartDateTime.IsNull() && !plan.StartDateTime.IsUnknown() {
+		v := plan.StartDateTime.ValueString()
+		upd.StartDateTime = &v
+	}
+	if !plan.EndDateTime.IsNull() && !plan.EndDateTime.IsUnknown() {
+		v := plan.EndDateTime.ValueString()
+		upd.EndDateTime = &v
+	}
 	// active is computed by the API, not updated by user
-	if !plan.Duration.IsNull() {
+	if !plan.Duration.IsNull() && !plan.Duration.IsUnknown() {
 		v := int(plan.Duration.ValueInt64())
 		upd.Duration = &v
 	}
-	if !plan.Timezone.IsNull() {
-		v := plan.Timezone.ValueString()
-		upd.Timezone = &v
-	}
-	if !plan.Cron.IsNull() {
-		v := plan.Cron.ValueString()
-		upd.Cron = &v
-	}
-	if !plan.IntervalDay.IsNull() {
-		v := int(plan.IntervalDay.ValueInt64())
-		upd.IntervalDay = &v
-	}
-	if !plan.StartTime.IsNull() {
-		v := plan.StartTime.ValueString()
-		upd.StartTime = &v
+	// Fields removed from the configuration, e.g. cron after a strategy switch, are
+	// cleared explicitly; weekdays and days_of_month are always sent
+	upd.Timezone = patchString(plan.Timezone, state.Timezone)
+	upd.Cron = patchString(plan.Cron, state.Cron)
+	upd.IntervalDay = patchInt(plan.IntervalDay, state.IntervalDay)
+	upd.StartTime = patchString(plan.StartTime, state.StartTime)
+	upd.EndTime = patchString(plan.EndTime, state.EndTime)
+
+	ids, managed, err := r.effectiveMonitorIDs(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("resolve maintenance monitors failed", err.Error())
+		return
 	}
-	if !plan.EndTime.IsNull() {
-		v := plan.EndTime.ValueString()
-		upd.EndTime = &v
+	if !managed {
+		// Keep the monitors the server has
+		ids, _ = knownStrings(state.EffectiveMonitorIDs.Elements(), false)
 	}
+	upd.MonitorIDs = ids
 
 	// Use state.ID instead of plan.ID
 	m, err := r.client.UpdateMaintenance(ctx, state.ID.ValueString(), upd)
@@ -453,6 +763,7 @@ func (r *MaintenanceResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 	setModelFromMaintenance(&plan, m)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, plan.ID.ValueString())...)
 }
 
 func (r *MaintenanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -469,9 +780,33 @@ func (r *MaintenanceResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *MaintenanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID, diags := importIDFromRequest(ctx, req, r.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := importLookup(importID, "name", func() ([]peekaping.Maintenance, error) {
+		list, err := r.client.ListMaintenance(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	}, func(x *peekaping.Maintenance) (string, string) { return x.Title, x.ID }, "maintenance")
+	if err != nil {
+		resp.Diagnostics.AddError("import failed", err.Error())
+		return
+	}
+
 	var state maintenanceResourceModel
-	state.ID = types.StringValue(req.ID)
+	state.ID = types.StringValue(id)
+	state.MonitorIDs = types.ListNull(types.StringType)
+	state.TagIDs = types.SetNull(types.StringType)
+	state.StatusPageIDs = types.SetNull(types.StringType)
+	state.EffectiveMonitorIDs = types.SetNull(types.StringType)
+	state.NextWindows = types.ListNull(types.ObjectType{AttrTypes: maintenanceWindowAttrTypes})
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, id)...)
 }
 
 func toIntSlice(xs []types.Int64) []int {
@@ -484,6 +819,25 @@ func toIntSlice(xs []types.Int64) []int {
 	return out
 }
 
+// patchString returns the update value of an optional string: the planned value, "" to
+// clear a value that was removed from the configuration, or nil to leave it unset.
+func patchString(plan, state types.String) *string {
+	if plan.IsNull() && state.IsNull() {
+		return nil
+	}
+	v := plan.ValueString()
+	return &v
+}
+
+// patchInt is patchString for optional integers, clearing with 0.
+func patchInt(plan, state types.Int64) *int {
+	if plan.IsNull() && state.IsNull() {
+		return nil
+	}
+	v := int(plan.ValueInt64())
+	return &v
+}
+
 // formatDateTime formats a datetime string to the format expected by the API
 
 func setModelFromMaintenanceWithState(m *maintenanceResourceModel, from *peekaping.Maintenance) {
@@ -509,14 +863,14 @@ func setModelFromMaintenance(m *maintenanceResourceModel, from *peekaping.Mainte
 	m.Strategy = types.StringValue(from.Strategy)
 	m.Active = types.BoolValue(from.Active)
 	if from.StartDateTime != "" {
-		m.StartDateTime = types.StringValue(from.StartDateTime)
+		m.StartDateTime = newDateTimeValue(normalizeDateTime(from.StartDateTime, from.Timezone))
 	} else {
-		m.StartDateTime = types.StringNull()
+		m.StartDateTime = newDateTimeNull()
 	}
 	if from.EndDateTime != "" {
-		m.EndDateTime = types.StringValue(from.EndDateTime)
+		m.EndDateTime = newDateTimeValue(normalizeDateTime(from.EndDateTime, from.Timezone))
 	} else {
-		m.EndDateTime = types.StringNull()
+		m.EndDateTime = newDateTimeNull()
 	}
 	if from.Duration != 0 {
 		m.Duration = types.Int64Value(int64(from.Duration))
@@ -559,12 +913,21 @@ func setModelFromMaintenance(m *maintenanceResourceModel, from *peekaping.Mainte
 		m.UpdatedAt = types.StringNull()
 	}
 
-	// Handle MonitorIDs - always set from API response since it's computed
-	ids := make([]attr.Value, 0, len(from.MonitorIDs))
-	for _, id := range from.MonitorIDs {
-		ids = append(ids, types.StringValue(id))
+	// monitor_ids mirrors the server unless selectors add monitors of their own
+	if m.TagIDs.IsNull() {
+		m.TagIDs = types.SetNull(types.StringType)
+	}
+	if m.StatusPageIDs.IsNull() {
+		m.StatusPageIDs = types.SetNull(types.StringType)
+	}
+	if (m.TagIDs.IsNull() && m.StatusPageIDs.IsNull()) || m.MonitorIDs.IsNull() || m.MonitorIDs.IsUnknown() {
+		ids := make([]attr.Value, 0, len(from.MonitorIDs))
+		for _, id := range from.MonitorIDs {
+			ids = append(ids, types.StringValue(id))
+		}
+		m.MonitorIDs = types.ListValueMust(types.StringType, ids)
 	}
-	m.MonitorIDs = types.ListValueMust(types.StringType, ids)
+	m.EffectiveMonitorIDs = toStringSet(slices.Compact(slices.Sorted(slices.Values(from.MonitorIDs))))
 	if len(from.Weekdays) > 0 {
 		days := make([]types.Int64, 0, len(from.Weekdays))
 		for _, d := range from.Weekdays {
@@ -583,4 +946,5 @@ func setModelFromMaintenance(m *maintenanceResourceModel, from *peekaping.Mainte
 	} else {
 		m.DaysOfMonth = nil
 	}
+	m.Status, m.NextWindows = maintenanceComputed(from, time.Now())
 }
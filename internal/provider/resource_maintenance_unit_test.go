@@ -0,0 +1,290 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
+)
+
+// TestCheckMaintenanceStrategy tests the per-strategy required and forbidden attributes.
+func TestCheckMaintenanceStrategy(t *testing.T) {
+	unset := func() map[string]attr.Value {
+		return map[string]attr.Value{
+			"start_date_time": newDateTimeNull(),
+			"end_date_time":   newDateTimeNull(),
+			"cron":            types.StringNull(),
+			"duration":        types.Int64Null(),
+			"weekdays":        types.ListNull(types.Int64Type),
+			"days_of_month":   types.ListNull(types.Int64Type),
+			"interval_day":    types.Int64Null(),
+			"start_time":      types.StringNull(),
+			"end_time":        types.StringNull(),
+		}
+	}
+	days := func(ds ...int64) types.List {
+		elems := make([]attr.Value, 0, len(ds))
+		for _, d := range ds {
+			elems = append(elems, types.Int64Value(d))
+		}
+		return types.ListValueMust(types.Int64Type, elems)
+	}
+
+	tests := []struct {
+		name     string
+		strategy string
+		set      map[string]attr.Value
+		want     []string // attribute paths with errors
+	}{
+		{"manual", "manual", nil, nil},
+		{"manual with cron", "manual", map[string]attr.Value{"cron": types.StringValue("@daily")}, []string{"cron"}},
+		{
+			"once", "once",
+			map[string]attr.Value{"start_date_time": newDateTimeValue("2030-01-01T02:00:00Z"), "end_date_time": newDateTimeValue("2030-01-01T04:00:00Z")},
+			nil,
+		},
+		{"once missing dates", "once", map[string]attr.Value{"duration": types.Int64Value(60)}, []string{"start_date_time", "end_date_time", "duration"}},
+		{
+			"cron with date range", "cron",
+			map[string]attr.Value{"cron": types.StringValue("@daily"), "duration": types.Int64Value(60), "end_date_time": newDateTimeValue("2030-01-01T00:00:00Z")},
+			nil,
+		},
+		{"cron", "cron", map[string]attr.Value{"cron": types.StringValue("0 2 * * *"), "duration": types.Int64Value(60)}, nil},
+		{"cron missing duration", "cron", map[string]attr.Value{"cron": types.StringValue("0 2 * * *")}, []string{"duration"}},
+		{"cron unknown duration", "cron", map[string]attr.Value{"cron": types.StringValue("0 2 * * *"), "duration": types.Int64Unknown()}, nil},
+		{
+			"weekday", "recurring-weekday",
+			map[string]attr.Value{"weekdays": days(1, 3), "start_time": types.StringValue("02:00"), "end_time": types.StringValue("03:00")},
+			nil,
+		},
+		{
+			"weekday with empty weekdays and days of month", "recurring-weekday",
+			map[string]attr.Value{"weekdays": days(), "days_of_month": days(1), "start_time": types.StringValue("02:00"), "end_time": types.StringValue("03:00")},
+			[]string{"weekdays", "days_of_month"},
+		},
+		{"day of month missing everything", "recurring-day-of-month", nil, []string{"days_of_month", "start_time", "end_time"}},
+		{
+			"interval with duration", "recurring-interval",
+			map[string]attr.Value{"interval_day": types.Int64Value(2), "start_time": types.StringValue("02:00"), "end_time": types.StringValue("03:00"), "duration": types.Int64Value(60)},
+			[]string{"duration"},
+		},
+		{"unknown strategy", "weekly", map[string]attr.Value{"cron": types.StringValue("@daily")}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := unset()
+			for k, v := range tt.set {
+				values[k] = v
+			}
+			var got []string
+			for _, d := range checkMaintenanceStrategy(tt.strategy, values) {
+				if d, ok := d.(interface{ Path() path.Path }); ok {
+					got = append(got, d.Path().String())
+				}
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("errors on %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("errors on %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+// TestMaintenanceDaysValidator tests the range checks on weekdays and days_of_month.
+func TestMaintenanceDaysValidator(t *testing.T) {
+	ctx := context.Background()
+	days := func(xs ...int64) types.List {
+		elems := make([]attr.Value, len(xs))
+		for i, x := range xs {
+			elems[i] = types.Int64Value(x)
+		}
+		return types.ListValueMust(types.Int64Type, elems)
+	}
+	tests := []struct {
+		name      string
+		value     types.List
+		wantPaths []path.Path
+	}{
+		{"weekdays", days(0, 3, 6), nil},
+		{"weekdays", days(1, 7, 9), []path.Path{path.Root("weekdays").AtListIndex(1), path.Root("weekdays").AtListIndex(2)}},
+		{"weekdays", days(-1), []path.Path{path.Root("weekdays").AtListIndex(0)}},
+		{"days_of_month", days(1, 15, 31), nil},
+		{"days_of_month", days(0, 32), []path.Path{path.Root("days_of_month").AtListIndex(0), path.Root("days_of_month").AtListIndex(1)}},
+		{"days_of_month", types.ListUnknown(types.Int64Type), nil},
+	}
+	for _, tt := range tests {
+		req := validator.ListRequest{Path: path.Root(tt.name), ConfigValue: tt.value}
+		var resp validator.ListResponse
+		maintenanceDaysValidator{name: tt.name}.ValidateList(ctx, req, &resp)
+		var got []path.Path
+		for _, d := range resp.Diagnostics.Errors() {
+			got = append(got, d.(diag.DiagnosticWithPath).Path())
+		}
+		if !slices.EqualFunc(got, tt.wantPaths, path.Path.Equal) {
+			t.Errorf("%s %v: errors at %v, want %v", tt.name, tt.value, got, tt.wantPaths)
+		}
+	}
+}
+
+// TestSetModelFromMaintenanceStatus tests that the server's status takes precedence
+// and one derived from the schedule fills in when it reports none.
+func TestSetModelFromMaintenanceStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		in   peekaping.Maintenance
+		want string
+	}{
+		{"server", peekaping.Maintenance{Strategy: "manual", Active: true, Status: "scheduled"}, "scheduled"},
+		{"manual", peekaping.Maintenance{Strategy: "manual", Active: true}, "under-maintenance"},
+		{"inactive", peekaping.Maintenance{Strategy: "manual"}, "inactive"},
+		{"ended", peekaping.Maintenance{
+			Strategy:      "once",
+			Active:        true,
+			StartDateTime: "2020-01-01T00:00:00Z",
+			EndDateTime:   "2020-01-02T00:00:00Z",
+		}, "ended"},
+	}
+	for _, tt := range tests {
+		var m maintenanceResourceModel
+		setModelFromMaintenance(&m, &tt.in)
+		if got := m.Status.ValueString(); got != tt.want {
+			t.Errorf("%s: status = %q, want %q", tt.name, got, tt.want)
+		}
+		if m.NextWindows.IsNull() {
+			t.Errorf("%s: next_windows is null", tt.name)
+		}
+	}
+}
+
+// TestMaintenanceUpdateStrategySwitch tests that switching a maintenance from cron to
+// once clears the cron schedule on the server instead of reading it back.
+func TestMaintenanceUpdateStrategySwitch(t *testing.T) {
+	ctx := context.Background()
+	stored := peekaping.Maintenance{
+		ID:         "mw",
+		Title:      "nightly",
+		Strategy:   "cron",
+		Active:     true,
+		MonitorIDs: []string{"mon"},
+		Timezone:   "UTC",
+		Cron:       "0 3 * * *",
+		Duration:   60,
+	}
+	var sent map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			body, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(body, &sent)
+			// Like the server, only overwrite the fields present in the payload
+			_ = json.Unmarshal(body, &stored)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": stored})
+	}))
+	t.Cleanup(srv.Close)
+	r := &MaintenanceResource{client: peekaping.New(srv.URL)}
+
+	var state maintenanceResourceModel
+	setModelFromMaintenance(&state, &stored)
+	plan := state
+	plan.Strategy = types.StringValue("once")
+	plan.Cron = types.StringNull()
+	plan.StartDateTime = newDateTimeValue("2030-01-01T02:00:00Z")
+	plan.EndDateTime = newDateTimeValue("2030-01-01T04:00:00Z")
+	plan.Duration = types.Int64Unknown()
+	plan.Status = types.StringUnknown()
+	plan.NextWindows = types.ListUnknown(types.ObjectType{AttrTypes: maintenanceWindowAttrTypes})
+
+	stateVal := newResourceState(t, r, state)
+	planVal := newResourceState(t, r, plan)
+	resp := resource.UpdateResponse{State: stateVal}
+	r.Update(ctx, resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: planVal.Schema, Raw: planVal.Raw},
+		State: stateVal,
+	}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("update: %v", resp.Diagnostics)
+	}
+	if cron, ok := sent["cron"]; !ok || cron != "" {
+		t.Errorf("update didn't clear cron: %v", sent)
+	}
+	if _, ok := sent["interval_day"]; ok {
+		t.Errorf("update sent interval_day that was never set: %v", sent)
+	}
+	var got maintenanceResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if !got.Cron.IsNull() || got.Strategy.ValueString() != "once" {
+		t.Errorf("state after update has strategy %s, cron %s", got.Strategy, got.Cron)
+	}
+}
+
+// TestDateTimeValue tests semantic equality and normalization of maintenance timestamps.
+func TestDateTimeValue(t *testing.T) {
+	ctx := context.Background()
+	for _, tt := range []struct {
+		a, b string
+		want bool
+	}{
+		{"2030-01-01T02:00:00Z", "2030-01-01T03:00:00+01:00", true},
+		{"2030-01-01T02:00:00Z", "2030-01-01T02:00:00.000Z", true},
+		{"2030-01-01T02:00:00Z", "2030-01-01T02:00:01Z", false},
+		{"garbage", "garbage", true},
+	} {
+		got, diags := newDateTimeValue(tt.a).StringSemanticEquals(ctx, newDateTimeValue(tt.b))
+		if diags.HasError() || got != tt.want {
+			t.Errorf("%s == %s: got %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+
+	for _, tt := range []struct {
+		in, timezone, want string
+	}{
+		{"2030-01-01T02:00:00.000Z", "", "2030-01-01T02:00:00Z"},
+		{"2030-01-01 02:00:00", "Europe/Berlin", "2030-01-01T02:00:00+01:00"},
+		{"2030-07-01T02:00", "Europe/Berlin", "2030-07-01T02:00:00+02:00"},
+		{"2030-01-01 02:00", "Not/AZone", "2030-01-01T02:00:00Z"},
+		{"tomorrow", "", "tomorrow"},
+	} {
+		if got := normalizeDateTime(tt.in, tt.timezone); got != tt.want {
+			t.Errorf("normalizeDateTime(%q, %q) = %q, want %q", tt.in, tt.timezone, got, tt.want)
+		}
+	}
+}
+
+// TestMonitorsWithTags tests resolving tag_ids selectors against monitor tags.
+func TestMonitorsWithTags(t *testing.T) {
+	monitors := []peekaping.Monitor{
+		{ID: "db-1", Tags: []peekaping.MonitorTag{{TagID: "db", Value: "primary"}}},
+		{ID: "db-2", TagIDs: []string{"db", "eu"}},
+		{ID: "web", TagIDs: []string{"web"}},
+		{ID: "untagged"},
+	}
+	if got := monitorsWithTags(monitors, []string{"db"}); !slices.Equal(got, []string{"db-1", "db-2"}) {
+		t.Errorf("db = %v", got)
+	}
+	if got := monitorsWithTags(monitors, []string{"eu", "web"}); !slices.Equal(got, []string{"db-2", "web"}) {
+		t.Errorf("eu, web = %v", got)
+	}
+	if got := monitorsWithTags(monitors, []string{"none"}); got != nil {
+		t.Errorf("none = %v", got)
+	}
+}
@@ -5,18 +5,26 @@ package provider
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -25,6 +33,10 @@ import (
 
 var _ resource.Resource = &MonitorResource{}
 var _ resource.ResourceWithImportState = &MonitorResource{}
+var _ resource.ResourceWithIdentity = &MonitorResource{}
+var _ resource.ResourceWithValidateConfig = &MonitorResource{}
+var _ resource.ResourceWithUpgradeState = &MonitorResource{}
+var _ resource.ResourceWithModifyPlan = &MonitorResource{}
 
 // monitorTypeValidator validates that the monitor type is supported.
 type monitorTypeValidator struct{}
@@ -272,6 +284,47 @@ func (v monitorResendIntervalValidator) ValidateInt64(ctx context.Context, req v
 	}
 }
 
+// unmanagedPlanModifier keeps the prior state of an association list or set when the
+// controlling manage_* flag is false, so changes made by the standalone association
+// resources don't show up as drift on the monitor.
+type unmanagedPlanModifier struct {
+	manageAttr string
+}
+
+func (m unmanagedPlanModifier) Description(_ context.Context) string {
+	return fmt.Sprintf("Use the prior state when %s is false", m.manageAttr)
+}
+
+func (m unmanagedPlanModifier) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("Use the prior state when `%s` is false", m.manageAttr)
+}
+
+func (m unmanagedPlanModifier) PlanModifyList(ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+	if req.StateValue.IsNull() || !req.ConfigValue.IsNull() {
+		return
+	}
+	if m.managed(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	resp.PlanValue = req.StateValue
+}
+
+func (m unmanagedPlanModifier) PlanModifySet(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+	if req.StateValue.IsNull() || !req.ConfigValue.IsNull() {
+		return
+	}
+	if m.managed(ctx, req.Plan, &resp.Diagnostics) {
+		return
+	}
+	resp.PlanValue = req.StateValue
+}
+
+func (m unmanagedPlanModifier) managed(ctx context.Context, plan tfsdk.Plan, diags *diag.Diagnostics) bool {
+	var manage types.Bool
+	diags.Append(plan.GetAttribute(ctx, path.Root(m.manageAttr), &manage)...)
+	return diags.HasError() || manage.IsUnknown() || manage.ValueBool()
+}
+
 type MonitorResource struct {
 	client *peekaping.Client
 }
@@ -291,8 +344,11 @@ type monitorResourceModel struct {
 	ResendInterval  types.Int64          `tfsdk:"resend_interval"`
 	ProxyID         types.String         `tfsdk:"proxy_id"`
 	PushToken       types.String         `tfsdk:"push_token"`
-	NotificationIDs []types.String       `tfsdk:"notification_ids"`
-	TagIDs          []types.String       `tfsdk:"tag_ids"`
+	PushURL         types.String         `tfsdk:"push_url"`
+	NotificationIDs types.List           `tfsdk:"notification_ids"`
+	Tags            types.Set            `tfsdk:"tags"`
+	ManageNotifs    types.Bool           `tfsdk:"manage_notifications"`
+	ManageTags      types.Bool           `tfsdk:"manage_tags"`
 	Status          types.Int64          `tfsdk:"status"`
 	CreatedAt       types.String         `tfsdk:"created_at"`
 	UpdatedAt       types.String         `tfsdk:"updated_at"`
@@ -304,6 +360,7 @@ func (r *MonitorResource) Metadata(_ context.Context, req resource.MetadataReque
 
 func (r *MonitorResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -402,19 +459,53 @@ func (r *MonitorResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 			},
 			"push_token": schema.StringAttribute{
 				Optional:    true,
-				Description: "Push token for push monitors",
+				Computed:    true,
+				Description: "Push token for push monitors. Generated when omitted.",
+			},
+			"push_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "URL that push monitors receive heartbeats on",
 			},
 			"notification_ids": schema.ListAttribute{
 				Optional:    true,
 				Computed:    true,
 				ElementType: types.StringType,
 				Description: "List of notification channel IDs",
+				PlanModifiers: []planmodifier.List{
+					unmanagedPlanModifier{manageAttr: "manage_notifications"},
+				},
 			},
-			"tag_ids": schema.ListAttribute{
+			"tags": schema.SetNestedAttribute{
 				Optional:    true,
 				Computed:    true,
-				ElementType: types.StringType,
-				Description: "List of tag IDs",
+				Description: "Tags assigned to the monitor, each with an optional per-monitor value",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"tag_id": schema.StringAttribute{
+							Required:    true,
+							Description: "Tag ID",
+						},
+						"value": schema.StringAttribute{
+							Optional:    true,
+							Description: "Per-monitor tag value (e.g. prod for an env tag)",
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.Set{
+					unmanagedPlanModifier{manageAttr: "manage_tags"},
+				},
+			},
+			"manage_notifications": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether this resource manages notification_ids. Set to false when notifications are attached with peekaping_monitor_notification. Defaults to true.",
+			},
+			"manage_tags": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether this resource manages tags. Set to false when tags are attached with peekaping_monitor_tag. Defaults to true.",
 			},
 			"status": schema.Int64Attribute{
 				Computed:    true,
@@ -435,6 +526,46 @@ func (r *MonitorResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 	}
 }
 
+func (r *MonitorResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config monitorResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.ManageNotifs.IsNull() && !config.ManageNotifs.IsUnknown() && !config.ManageNotifs.ValueBool() && !config.NotificationIDs.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("notification_ids"),
+			"Conflicting Configuration",
+			"notification_ids cannot be set when manage_notifications is false. Attach notifications with peekaping_monitor_notification instead.",
+		)
+	}
+	if !config.ManageTags.IsNull() && !config.ManageTags.IsUnknown() && !config.ManageTags.ValueBool() && !config.Tags.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tags"),
+			"Conflicting Configuration",
+			"tags cannot be set when manage_tags is false. Attach tags with peekaping_monitor_tag instead.",
+		)
+	}
+
+	// Check config against the per-type rules the config functions use once both are
+	// known; malformed JSON is reported by monitorConfigValidator
+	if config.Type.IsNull() || config.Type.IsUnknown() || config.Config.IsNull() || config.Config.IsUnknown() {
+		return
+	}
+	var cfg map[string]any
+	if err := json.Unmarshal([]byte(config.Config.ValueString()), &cfg); err != nil {
+		return
+	}
+	if err := validateMonitorConfig(config.Type.ValueString(), cfg); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("config"), "Invalid Monitor Configuration", err.Error())
+	}
+}
+
+func (r *MonitorResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *MonitorResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -457,17 +588,22 @@ func (r *MonitorResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	notificationIDs := toStrSlice(plan.NotificationIDs)
+	notificationIDs := toStrSliceFromList(plan.NotificationIDs)
 	if notificationIDs == nil {
 		notificationIDs = []string{}
 	}
+	tags, diags := monitorTagsFromSet(ctx, plan.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Log the config we're sending for debugging
 	tflog.Info(ctx, "Creating monitor", map[string]interface{}{
-		"name":    plan.Name.ValueString(),
-		"type":    plan.Type.ValueString(),
-		"config":  plan.Config.ValueString(),
-		"tag_ids": toStrSlice(plan.TagIDs),
+		"name":   plan.Name.ValueString(),
+		"type":   plan.Type.ValueString(),
+		"config": plan.Config.ValueString(),
+		"tags":   tags,
 	})
 
 	// Handle default values
@@ -511,12 +647,18 @@ func (r *MonitorResource) Create(ctx context.Context, req resource.CreateRequest
 		RetryInterval:   retryInterval,
 		ResendInterval:  resendInterval,
 		Active:          active,
-		NotificationIDs: notificationIDs,         // Always send, even if empty (API requires it)
-		TagIDs:          toStrSlice(plan.TagIDs), // Always send, even if empty (API requires it)
+		NotificationIDs: notificationIDs, // Always send, even if empty (API requires it)
+	}
+	if len(tags) > 0 {
+		setMonitorCreateTags(&in, tags)
 	}
 	if !plan.ProxyID.IsNull() {
 		in.ProxyID = plan.ProxyID.ValueString()
 	}
+	if err := resolvePushToken(&plan); err != nil {
+		resp.Diagnostics.AddError("generate push token failed", err.Error())
+		return
+	}
 	if !plan.PushToken.IsNull() {
 		in.PushToken = plan.PushToken.ValueString()
 	}
@@ -527,6 +669,7 @@ func (r *MonitorResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 	setModelFromMonitor(ctx, &plan, m)
+	plan.PushURL = r.pushURL(&plan)
 
 	// Preserve the plan's active value to maintain Terraform state consistency
 	// The API may return different defaults than what the plan specifies
@@ -535,6 +678,7 @@ func (r *MonitorResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, plan.ID.ValueString())...)
 }
 
 func (r *MonitorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -554,17 +698,19 @@ func (r *MonitorResource) Read(ctx context.Context, req resource.ReadRequest, re
 	tflog.Info(ctx, "API returned monitor", map[string]interface{}{
 		"id":               m.ID,
 		"name":             m.Name,
-		"tag_ids":          m.TagIDs,
+		"tags":             m.Tags,
 		"notification_ids": m.NotificationIDs,
 		"active":           m.Active,
 	})
 
-	// Use regular field mapping but don't touch tag_ids and notification_ids
+	// Use regular field mapping but don't touch tags and notification_ids
 	// since the API doesn't return these fields and we want to preserve current state
 	setModelFromMonitor(ctx, &state, m)
+	state.PushURL = r.pushURL(&state)
 
-	// Don't modify tag_ids and notification_ids - let Terraform preserve them from current state
+	// Don't modify tags and notification_ids - let Terraform preserve them from current state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, state.ID.ValueString())...)
 }
 
 func (r *MonitorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -581,9 +727,42 @@ func (r *MonitorResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	unlock := lockMonitor(state.ID.ValueString())
+	defer unlock()
+
+	// Always send both lists, even if empty (API requires it)
 	upd := peekaping.MonitorUpdate{
-		NotificationIDs: toStrSlice(plan.NotificationIDs), // Always send, even if empty (API requires it)
-		TagIDs:          toStrSlice(plan.TagIDs),          // Always send, even if empty (API requires it)
+		NotificationIDs: []string{},
+		TagIDs:          []string{},
+	}
+
+	// Lists that are not managed here (or not configured at all) keep whatever the
+	// server currently has, since the update replaces them wholesale.
+	notifsManaged := plan.ManageNotifs.ValueBool() && !plan.NotificationIDs.IsUnknown()
+	tagsManaged := plan.ManageTags.ValueBool() && !plan.Tags.IsUnknown()
+	if notifsManaged {
+		upd.NotificationIDs = append(upd.NotificationIDs, toStrSliceFromList(plan.NotificationIDs)...)
+	}
+	if tagsManaged {
+		tags, diags := monitorTagsFromSet(ctx, plan.Tags)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		setMonitorUpdateTags(&upd, tags)
+	}
+	if !notifsManaged || !tagsManaged {
+		current, err := r.client.GetMonitor(ctx, state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("read monitor failed", err.Error())
+			return
+		}
+		if !notifsManaged {
+			upd.NotificationIDs = append(upd.NotificationIDs, current.NotificationIDs...)
+		}
+		if !tagsManaged {
+			setMonitorUpdateTags(&upd, monitorTagsOf(current))
+		}
 	}
 	if !plan.Name.IsNull() {
 		v := plan.Name.ValueString()
@@ -626,6 +805,10 @@ func (r *MonitorResource) Update(ctx context.Context, req resource.UpdateRequest
 		v := plan.ProxyID.ValueString()
 		upd.ProxyID = &v
 	}
+	if err := resolvePushToken(&plan); err != nil {
+		resp.Diagnostics.AddError("generate push token failed", err.Error())
+		return
+	}
 	if !plan.PushToken.IsNull() {
 		v := plan.PushToken.ValueString()
 		upd.PushToken = &v
@@ -652,7 +835,81 @@ func (r *MonitorResource) Update(ctx context.Context, req resource.UpdateRequest
 	// Note: We don't set CreatedAt/UpdatedAt here as they can change during updates
 
 	setModelFromMonitorWithState(&plan, fullMonitor, &state)
+	plan.PushURL = r.pushURL(&plan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, plan.ID.ValueString())...)
+}
+
+// ModifyPlan keeps a generated push_token stable across plans and derives push_url,
+// so both are known at plan time whenever possible.
+func (r *MonitorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var monitorType, configToken, planToken types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &monitorType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("push_token"), &configToken)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("push_token"), &planToken)...)
+	if resp.Diagnostics.HasError() || monitorType.IsUnknown() {
+		return
+	}
+
+	pushURL := types.StringUnknown()
+	if monitorType.ValueString() != string(peekaping.MonitorPush) {
+		if configToken.IsNull() {
+			planToken = types.StringNull()
+		}
+		pushURL = types.StringNull()
+	} else if configToken.IsNull() && !req.State.Raw.IsNull() {
+		var stateToken types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("push_token"), &stateToken)...)
+		if !stateToken.IsNull() {
+			planToken = stateToken
+		}
+	}
+
+	if monitorType.ValueString() == string(peekaping.MonitorPush) && !planToken.IsUnknown() && r.client != nil {
+		pushURL = types.StringValue(r.client.PushURL(planToken.ValueString()))
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("push_token"), planToken)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("push_url"), pushURL)...)
+}
+
+// pushURL derives push_url for push monitors with a token, and null otherwise.
+func (r *MonitorResource) pushURL(m *monitorResourceModel) types.String {
+	if m.Type.ValueString() != string(peekaping.MonitorPush) || m.PushToken.ValueString() == "" || r.client == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(r.client.PushURL(m.PushToken.ValueString()))
+}
+
+// resolvePushToken generates a push token for push monitors whose token is left to the
+// provider, and clears it for other monitor types.
+func resolvePushToken(m *monitorResourceModel) error {
+	if !m.PushToken.IsUnknown() {
+		return nil
+	}
+	if m.Type.ValueString() != string(peekaping.MonitorPush) {
+		m.PushToken = types.StringNull()
+		return nil
+	}
+	token, err := generatePushToken()
+	if err != nil {
+		return err
+	}
+	m.PushToken = types.StringValue(token)
+	return nil
+}
+
+// generatePushToken returns a cryptographically random, URL-safe push token.
+func generatePushToken() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
 }
 
 func (r *MonitorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -669,19 +926,137 @@ func (r *MonitorResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *MonitorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID, diags := importIDFromRequest(ctx, req, r.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := importLookup(importID, "name", func() ([]peekaping.Monitor, error) {
+		list, err := r.client.ListMonitors(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	}, func(x *peekaping.Monitor) (string, string) { return x.Name, x.ID }, "monitor")
+	if err != nil {
+		resp.Diagnostics.AddError("import failed", err.Error())
+		return
+	}
+
 	var state monitorResourceModel
-	state.ID = types.StringValue(req.ID)
+	state.ID = types.StringValue(id)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, id)...)
 }
 
-func toStrSlice(xs []types.String) []string {
-	out := make([]string, 0, len(xs))
-	for _, s := range xs {
-		if !s.IsNull() {
-			out = append(out, s.ValueString())
-		}
+// monitorResourceModelV0 is the schema version 0 state, which tracked tags as a plain
+// tag_ids list without per-monitor values.
+type monitorResourceModelV0 struct {
+	ID              types.String         `tfsdk:"id"`
+	Name            types.String         `tfsdk:"name"`
+	Type            types.String         `tfsdk:"type"`
+	Config          jsontypes.Normalized `tfsdk:"config"`
+	Interval        types.Int64          `tfsdk:"interval"`
+	Active          types.Bool           `tfsdk:"active"`
+	Timeout         types.Int64          `tfsdk:"timeout"`
+	MaxRetries      types.Int64          `tfsdk:"max_retries"`
+	RetryInterval   types.Int64          `tfsdk:"retry_interval"`
+	ResendInterval  types.Int64          `tfsdk:"resend_interval"`
+	ProxyID         types.String         `tfsdk:"proxy_id"`
+	PushToken       types.String         `tfsdk:"push_token"`
+	NotificationIDs types.List           `tfsdk:"notification_ids"`
+	TagIDs          types.List           `tfsdk:"tag_ids"`
+	ManageNotifs    types.Bool           `tfsdk:"manage_notifications"`
+	ManageTags      types.Bool           `tfsdk:"manage_tags"`
+	Status          types.Int64          `tfsdk:"status"`
+	CreatedAt       types.String         `tfsdk:"created_at"`
+	UpdatedAt       types.String         `tfsdk:"updated_at"`
+}
+
+// monitorV0Attributes lists the version 0 attributes that are unchanged in the current schema.
+var monitorV0Attributes = []string{
+	"id", "name", "type", "config", "interval", "active", "timeout", "max_retries",
+	"retry_interval", "resend_interval", "proxy_id", "push_token", "notification_ids",
+	"manage_notifications", "manage_tags", "status", "created_at", "updated_at",
+}
+
+func (r *MonitorResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var current resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &current)
+
+	prior := current.Schema
+	prior.Version = 0
+	prior.Attributes = make(map[string]schema.Attribute, len(monitorV0Attributes)+1)
+	for _, name := range monitorV0Attributes {
+		prior.Attributes[name] = current.Schema.Attributes[name]
+	}
+	prior.Attributes["tag_ids"] = schema.ListAttribute{
+		Optional:    true,
+		Computed:    true,
+		ElementType: types.StringType,
+	}
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &prior,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var old monitorResourceModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &old)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				tags := types.SetNull(types.ObjectType{AttrTypes: monitorTagAttrTypes})
+				if !old.TagIDs.IsNull() && !old.TagIDs.IsUnknown() {
+					var ids []string
+					resp.Diagnostics.Append(old.TagIDs.ElementsAs(ctx, &ids, false)...)
+					if resp.Diagnostics.HasError() {
+						return
+					}
+					assigned := make([]peekaping.MonitorTag, 0, len(ids))
+					for _, id := range ids {
+						assigned = append(assigned, peekaping.MonitorTag{TagID: id})
+					}
+					tags = monitorTagsToSet(assigned)
+				}
+
+				upgraded := monitorResourceModel{
+					ID:              old.ID,
+					Name:            old.Name,
+					Type:            old.Type,
+					Config:          old.Config,
+					Interval:        old.Interval,
+					Active:          old.Active,
+					Timeout:         old.Timeout,
+					MaxRetries:      old.MaxRetries,
+					RetryInterval:   old.RetryInterval,
+					ResendInterval:  old.ResendInterval,
+					ProxyID:         old.ProxyID,
+					PushToken:       old.PushToken,
+					PushURL:         types.StringNull(),
+					NotificationIDs: old.NotificationIDs,
+					Tags:            tags,
+					ManageNotifs:    old.ManageNotifs,
+					ManageTags:      old.ManageTags,
+					Status:          old.Status,
+					CreatedAt:       old.CreatedAt,
+					UpdatedAt:       old.UpdatedAt,
+				}
+				resp.Diagnostics.Append(resp.State.Set(ctx, &upgraded)...)
+			},
+		},
 	}
-	return out
+}
+
+// toStringList converts a string slice from the API into a Terraform list, using an
+// empty list rather than null when the API returns nothing.
+func toStringList(xs []string) types.List {
+	ids := make([]attr.Value, 0, len(xs))
+	for _, x := range xs {
+		ids = append(ids, types.StringValue(x))
+	}
+	return types.ListValueMust(types.StringType, ids)
 }
 
 func setModelFromMonitor(ctx context.Context, m *monitorResourceModel, from *peekaping.Monitor) {
@@ -770,24 +1145,18 @@ func setModelFromMonitor(ctx context.Context, m *monitorResourceModel, from *pee
 		m.UpdatedAt = types.StringValue(from.UpdatedAt)
 	}
 
-	// Handle TagIDs - populate from API response
-	if from.TagIDs != nil {
-		m.TagIDs = make([]types.String, len(from.TagIDs))
-		for i, id := range from.TagIDs {
-			m.TagIDs[i] = types.StringValue(id)
-		}
-	} else {
-		m.TagIDs = []types.String{}
-	}
+	// Handle Tags - populate from API response
+	m.Tags = monitorTagsToSet(monitorTagsOf(from))
 
 	// Handle NotificationIDs - populate from API response
-	if from.NotificationIDs != nil {
-		m.NotificationIDs = make([]types.String, len(from.NotificationIDs))
-		for i, id := range from.NotificationIDs {
-			m.NotificationIDs[i] = types.StringValue(id)
-		}
-	} else {
-		m.NotificationIDs = []types.String{}
+	m.NotificationIDs = toStringList(from.NotificationIDs)
+
+	// The manage_* flags are provider-side only; default them on import
+	if m.ManageNotifs.IsNull() || m.ManageNotifs.IsUnknown() {
+		m.ManageNotifs = types.BoolValue(true)
+	}
+	if m.ManageTags.IsNull() || m.ManageTags.IsUnknown() {
+		m.ManageTags = types.BoolValue(true)
 	}
 }
 
@@ -893,23 +1262,75 @@ func setModelFromMonitorWithState(m *monitorResourceModel, from *peekaping.Monit
 		m.UpdatedAt = types.StringValue(from.UpdatedAt)
 	}
 
-	// Handle TagIDs - populate from API response
-	if from.TagIDs != nil {
-		m.TagIDs = make([]types.String, len(from.TagIDs))
-		for i, id := range from.TagIDs {
-			m.TagIDs[i] = types.StringValue(id)
-		}
-	} else {
-		m.TagIDs = []types.String{}
-	}
+	// Handle Tags - populate from API response
+	m.Tags = monitorTagsToSet(monitorTagsOf(from))
 
 	// Handle NotificationIDs - populate from API response
-	if from.NotificationIDs != nil {
-		m.NotificationIDs = make([]types.String, len(from.NotificationIDs))
-		for i, id := range from.NotificationIDs {
-			m.NotificationIDs[i] = types.StringValue(id)
-		}
-	} else {
-		m.NotificationIDs = []types.String{}
+	m.NotificationIDs = toStringList(from.NotificationIDs)
+
+	// The manage_* flags are provider-side only; default them on import
+	if m.ManageNotifs.IsNull() || m.ManageNotifs.IsUnknown() {
+		m.ManageNotifs = types.BoolValue(true)
+	}
+	if m.ManageTags.IsNull() || m.ManageTags.IsUnknown() {
+		m.ManageTags = types.BoolValue(true)
+	}
+}
+
+// monitorLocks serializes read-modify-write updates of a single monitor, which the
+// association resources and the monitor resource perform concurrently during apply.
+var (
+	monitorLocksMu sync.Mutex
+	monitorLocks   = map[string]*sync.Mutex{}
+)
+
+func lockMonitor(id string) func() {
+	monitorLocksMu.Lock()
+	mu, ok := monitorLocks[id]
+	if !ok {
+		mu = &sync.Mutex{}
+		monitorLocks[id] = mu
+	}
+	monitorLocksMu.Unlock()
+
+	mu.Lock()
+	return mu.Unlock
+}
+
+// monitorUpdateFromMonitor builds a full update payload from a monitor as returned by
+// the API, so callers can change a single field without resetting the others.
+func monitorUpdateFromMonitor(from *peekaping.Monitor) peekaping.MonitorUpdate {
+	upd := peekaping.MonitorUpdate{
+		Name:            &from.Name,
+		Type:            &from.Type,
+		Active:          &from.Active,
+		NotificationIDs: append([]string{}, from.NotificationIDs...),
+		TagIDs:          append([]string{}, from.TagIDs...),
+		Tags:            append([]peekaping.MonitorTag{}, from.Tags...),
+	}
+	if from.Config != "" {
+		upd.Config = &from.Config
+	}
+	if from.Interval > 0 {
+		upd.Interval = &from.Interval
+	}
+	if from.Timeout > 0 {
+		upd.Timeout = &from.Timeout
+	}
+	if from.MaxRetries > 0 {
+		upd.MaxRetries = &from.MaxRetries
+	}
+	if from.RetryInterval > 0 {
+		upd.RetryInterval = &from.RetryInterval
+	}
+	if from.ResendInterval > 0 {
+		upd.ResendInterval = &from.ResendInterval
+	}
+	if from.ProxyID != "" {
+		upd.ProxyID = &from.ProxyID
+	}
+	if from.PushToken != "" {
+		upd.PushToken = &from.PushToken
 	}
+	return upd
 }
@@ -0,0 +1,209 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
+)
+
+var _ resource.Resource = &MonitorNotificationResource{}
+var _ resource.ResourceWithImportState = &MonitorNotificationResource{}
+
+// MonitorNotificationResource attaches a single notification channel to a monitor
+// without taking ownership of the monitor's full notification_ids list.
+type MonitorNotificationResource struct {
+	client *peekaping.Client
+}
+
+func NewMonitorNotificationResource() resource.Resource { return &MonitorNotificationResource{} }
+
+type monitorNotificationResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	MonitorID      types.String `tfsdk:"monitor_id"`
+	NotificationID types.String `tfsdk:"notification_id"`
+}
+
+func (r *MonitorNotificationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitor_notification"
+}
+
+func (r *MonitorNotificationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Attaches a notification channel to a monitor. Use together with `manage_notifications = false` on `peekaping_monitor`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Association ID in the form monitor_id/notification_id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"monitor_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Monitor ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"notification_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Notification channel ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *MonitorNotificationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*peekaping.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *peekaping.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *MonitorNotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan monitorNotificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitorID := plan.MonitorID.ValueString()
+	notificationID := plan.NotificationID.ValueString()
+
+	tflog.Info(ctx, "Attaching notification to monitor", map[string]interface{}{
+		"monitor_id":      monitorID,
+		"notification_id": notificationID,
+	})
+
+	unlock := lockMonitor(monitorID)
+	defer unlock()
+
+	m, err := r.client.GetMonitor(ctx, monitorID)
+	if err != nil {
+		resp.Diagnostics.AddError("read monitor failed", err.Error())
+		return
+	}
+	if !slices.Contains(m.NotificationIDs, notificationID) {
+		upd := monitorUpdateFromMonitor(m)
+		upd.NotificationIDs = append(upd.NotificationIDs, notificationID)
+		if _, err := r.client.UpdateMonitor(ctx, monitorID, upd); err != nil {
+			resp.Diagnostics.AddError("attach notification failed", err.Error())
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(monitorID + "/" + notificationID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *MonitorNotificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state monitorNotificationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	m, err := r.client.GetMonitor(ctx, state.MonitorID.ValueString())
+	if peekaping.IsNotFound(err) {
+		// The monitor was deleted outside of Terraform, taking the association with it
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("read monitor failed", err.Error())
+		return
+	}
+	if !slices.Contains(m.NotificationIDs, state.NotificationID.ValueString()) {
+		// Detached outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(state.MonitorID.ValueString() + "/" + state.NotificationID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *MonitorNotificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All arguments force replacement, so there is nothing to update in place
+	var plan monitorNotificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *MonitorNotificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state monitorNotificationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitorID := state.MonitorID.ValueString()
+	notificationID := state.NotificationID.ValueString()
+
+	unlock := lockMonitor(monitorID)
+	defer unlock()
+
+	m, err := r.client.GetMonitor(ctx, monitorID)
+	if peekaping.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("read monitor failed", err.Error())
+		return
+	}
+	if !slices.Contains(m.NotificationIDs, notificationID) {
+		return
+	}
+
+	upd := monitorUpdateFromMonitor(m)
+	upd.NotificationIDs = slices.DeleteFunc(upd.NotificationIDs, func(id string) bool { return id == notificationID })
+	if _, err := r.client.UpdateMonitor(ctx, monitorID, upd); err != nil {
+		resp.Diagnostics.AddError("detach notification failed", err.Error())
+		return
+	}
+}
+
+func (r *MonitorNotificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	monitorID, notificationID, ok := strings.Cut(req.ID, "/")
+	if !ok || monitorID == "" || notificationID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID of the form monitor_id/notification_id, got: %q", req.ID),
+		)
+		return
+	}
+
+	state := monitorNotificationResourceModel{
+		ID:             types.StringValue(req.ID),
+		MonitorID:      types.StringValue(monitorID),
+		NotificationID: types.StringValue(notificationID),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
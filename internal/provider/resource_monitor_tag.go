@@ -0,0 +1,327 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
+)
+
+var _ resource.Resource = &MonitorTagResource{}
+var _ resource.ResourceWithImportState = &MonitorTagResource{}
+
+// MonitorTagResource attaches a single tag, with an optional value, to a monitor
+// without taking ownership of the monitor's full tag list.
+type MonitorTagResource struct {
+	client *peekaping.Client
+}
+
+func NewMonitorTagResource() resource.Resource { return &MonitorTagResource{} }
+
+type monitorTagResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	MonitorID types.String `tfsdk:"monitor_id"`
+	TagID     types.String `tfsdk:"tag_id"`
+	Value     types.String `tfsdk:"value"`
+}
+
+func (r *MonitorTagResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitor_tag"
+}
+
+func (r *MonitorTagResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Attaches a tag to a monitor. Use together with `manage_tags = false` on `peekaping_monitor`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Association ID in the form monitor_id/tag_id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"monitor_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Monitor ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tag_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Tag ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Optional:    true,
+				Description: "Per-monitor tag value (e.g. prod for an env tag)",
+			},
+		},
+	}
+}
+
+func (r *MonitorTagResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*peekaping.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *peekaping.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *MonitorTagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan monitorTagResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Attaching tag to monitor", map[string]interface{}{
+		"monitor_id": plan.MonitorID.ValueString(),
+		"tag_id":     plan.TagID.ValueString(),
+		"value":      plan.Value.ValueString(),
+	})
+
+	if err := r.setMonitorTag(ctx, plan.MonitorID.ValueString(), plan.TagID.ValueString(), plan.Value.ValueString()); err != nil {
+		resp.Diagnostics.AddError("attach tag failed", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(plan.MonitorID.ValueString() + "/" + plan.TagID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *MonitorTagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state monitorTagResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	m, err := r.client.GetMonitor(ctx, state.MonitorID.ValueString())
+	if peekaping.IsNotFound(err) {
+		// The monitor was deleted outside of Terraform, taking the association with it
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("read monitor failed", err.Error())
+		return
+	}
+
+	tagID := state.TagID.ValueString()
+	idx := slices.IndexFunc(monitorTagsOf(m), func(t peekaping.MonitorTag) bool { return t.TagID == tagID })
+	if idx < 0 {
+		// Detached outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Only trust the value when the API returned full tag assignments
+	if len(m.Tags) > 0 {
+		if v := monitorTagsOf(m)[idx].Value; v != "" {
+			state.Value = types.StringValue(v)
+		} else {
+			state.Value = types.StringNull()
+		}
+	}
+
+	state.ID = types.StringValue(state.MonitorID.ValueString() + "/" + tagID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *MonitorTagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan monitorTagResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only value can change in place; monitor_id and tag_id force replacement
+	if err := r.setMonitorTag(ctx, plan.MonitorID.ValueString(), plan.TagID.ValueString(), plan.Value.ValueString()); err != nil {
+		resp.Diagnostics.AddError("update tag value failed", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(plan.MonitorID.ValueString() + "/" + plan.TagID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *MonitorTagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state monitorTagResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitorID := state.MonitorID.ValueString()
+	tagID := state.TagID.ValueString()
+
+	unlock := lockMonitor(monitorID)
+	defer unlock()
+
+	m, err := r.client.GetMonitor(ctx, monitorID)
+	if peekaping.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("read monitor failed", err.Error())
+		return
+	}
+
+	tags := monitorTagsOf(m)
+	remaining := slices.DeleteFunc(slices.Clone(tags), func(t peekaping.MonitorTag) bool { return t.TagID == tagID })
+	if len(remaining) == len(tags) {
+		return
+	}
+
+	upd := monitorUpdateFromMonitor(m)
+	setMonitorUpdateTags(&upd, remaining)
+	if _, err := r.client.UpdateMonitor(ctx, monitorID, upd); err != nil {
+		resp.Diagnostics.AddError("detach tag failed", err.Error())
+		return
+	}
+}
+
+func (r *MonitorTagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	monitorID, tagID, ok := strings.Cut(req.ID, "/")
+	if !ok || monitorID == "" || tagID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID of the form monitor_id/tag_id, got: %q", req.ID),
+		)
+		return
+	}
+
+	state := monitorTagResourceModel{
+		ID:        types.StringValue(req.ID),
+		MonitorID: types.StringValue(monitorID),
+		TagID:     types.StringValue(tagID),
+		Value:     types.StringNull(),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// setMonitorTag adds the tag to the monitor, or updates its value if already attached.
+func (r *MonitorTagResource) setMonitorTag(ctx context.Context, monitorID, tagID, value string) error {
+	unlock := lockMonitor(monitorID)
+	defer unlock()
+
+	m, err := r.client.GetMonitor(ctx, monitorID)
+	if err != nil {
+		return err
+	}
+
+	tags := monitorTagsOf(m)
+	if idx := slices.IndexFunc(tags, func(t peekaping.MonitorTag) bool { return t.TagID == tagID }); idx >= 0 {
+		if tags[idx].Value == value {
+			return nil
+		}
+		tags[idx].Value = value
+	} else {
+		tags = append(tags, peekaping.MonitorTag{TagID: tagID, Value: value})
+	}
+
+	upd := monitorUpdateFromMonitor(m)
+	setMonitorUpdateTags(&upd, tags)
+	_, err = r.client.UpdateMonitor(ctx, monitorID, upd)
+	return err
+}
+
+// monitorTagsOf returns the monitor's tag assignments, falling back to bare tag IDs
+// for servers that don't return per-monitor values.
+func monitorTagsOf(m *peekaping.Monitor) []peekaping.MonitorTag {
+	if len(m.Tags) > 0 {
+		return slices.Clone(m.Tags)
+	}
+	tags := make([]peekaping.MonitorTag, 0, len(m.TagIDs))
+	for _, id := range m.TagIDs {
+		tags = append(tags, peekaping.MonitorTag{TagID: id})
+	}
+	return tags
+}
+
+// setMonitorUpdateTags keeps tag_ids and tags in sync on an update payload.
+func setMonitorUpdateTags(upd *peekaping.MonitorUpdate, tags []peekaping.MonitorTag) {
+	upd.Tags = append([]peekaping.MonitorTag{}, tags...)
+	upd.TagIDs = make([]string, 0, len(tags))
+	for _, t := range tags {
+		upd.TagIDs = append(upd.TagIDs, t.TagID)
+	}
+}
+
+// setMonitorCreateTags keeps tag_ids and tags in sync on a create payload.
+func setMonitorCreateTags(in *peekaping.MonitorCreate, tags []peekaping.MonitorTag) {
+	in.Tags = tags
+	in.TagIDs = make([]string, 0, len(tags))
+	for _, t := range tags {
+		in.TagIDs = append(in.TagIDs, t.TagID)
+	}
+}
+
+// monitorTagAttrTypes describes one element of the monitor tags set.
+var monitorTagAttrTypes = map[string]attr.Type{
+	"tag_id": types.StringType,
+	"value":  types.StringType,
+}
+
+type monitorTagModel struct {
+	TagID types.String `tfsdk:"tag_id"`
+	Value types.String `tfsdk:"value"`
+}
+
+// monitorTagsFromSet converts the tags set from a plan into API tag assignments.
+func monitorTagsFromSet(ctx context.Context, set types.Set) ([]peekaping.MonitorTag, diag.Diagnostics) {
+	tags := []peekaping.MonitorTag{}
+	if set.IsNull() || set.IsUnknown() {
+		return tags, nil
+	}
+	var elems []monitorTagModel
+	diags := set.ElementsAs(ctx, &elems, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	for _, e := range elems {
+		tags = append(tags, peekaping.MonitorTag{TagID: e.TagID.ValueString(), Value: e.Value.ValueString()})
+	}
+	return tags, diags
+}
+
+// monitorTagsToSet converts API tag assignments into the tags set, mapping empty values to null.
+func monitorTagsToSet(tags []peekaping.MonitorTag) types.Set {
+	elemType := types.ObjectType{AttrTypes: monitorTagAttrTypes}
+	elems := make([]attr.Value, 0, len(tags))
+	for _, t := range tags {
+		value := types.StringNull()
+		if t.Value != "" {
+			value = types.StringValue(t.Value)
+		}
+		elems = append(elems, types.ObjectValueMust(monitorTagAttrTypes, map[string]attr.Value{
+			"tag_id": types.StringValue(t.TagID),
+			"value":  value,
+		}))
+	}
+	return types.SetValueMust(elemType, elems)
+}
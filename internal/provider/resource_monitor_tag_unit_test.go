@@ -0,0 +1,236 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
+)
+
+// fakeMonitorAPI serves GET and PUT /monitors/{id} from memory and records the
+// update payloads it receives.
+type fakeMonitorAPI struct {
+	mu       sync.Mutex
+	monitors map[string]peekaping.Monitor
+	updates  []map[string]any
+}
+
+func newFakeMonitorAPI(t *testing.T, monitors ...peekaping.Monitor) (*fakeMonitorAPI, *peekaping.Client) {
+	api := &fakeMonitorAPI{monitors: map[string]peekaping.Monitor{}}
+	for _, m := range monitors {
+		api.monitors[m.ID] = m
+	}
+	srv := httptest.NewServer(http.HandlerFunc(api.serveHTTP))
+	t.Cleanup(srv.Close)
+	return api, peekaping.New(srv.URL)
+}
+
+func (a *fakeMonitorAPI) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	id := strings.TrimPrefix(r.URL.Path, "/api/v1/monitors/")
+	m, ok := a.monitors[id]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(map[string]string{"message": "monitor not found"})
+		return
+	}
+	if r.Method == http.MethodPut {
+		var raw map[string]any
+		var upd peekaping.MonitorUpdate
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &raw)
+		_ = json.Unmarshal(body, &upd)
+		a.updates = append(a.updates, raw)
+		m.NotificationIDs = upd.NotificationIDs
+		m.TagIDs = upd.TagIDs
+		m.Tags = upd.Tags
+		a.monitors[id] = m
+	}
+	_ = json.NewEncoder(w).Encode(map[string]any{"data": m})
+}
+
+// lastUpdate returns the most recent update payload, failing if there is none.
+func (a *fakeMonitorAPI) lastUpdate(t *testing.T) map[string]any {
+	t.Helper()
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if len(a.updates) == 0 {
+		t.Fatal("expected a monitor update")
+	}
+	return a.updates[len(a.updates)-1]
+}
+
+// newResourceState returns a state of r holding model.
+func newResourceState(t *testing.T, r resource.Resource, model any) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+	state := tfsdk.State{Schema: resp.Schema, Raw: tftypes.NewValue(resp.Schema.Type().TerraformType(ctx), nil)}
+	if diags := state.Set(ctx, model); diags.HasError() {
+		t.Fatalf("set state: %v", diags)
+	}
+	return state
+}
+
+// TestMonitorTagResource tests attaching, updating and detaching a tag without
+// touching the monitor's other tags and settings.
+func TestMonitorTagResource(t *testing.T) {
+	ctx := context.Background()
+	api, client := newFakeMonitorAPI(t, peekaping.Monitor{
+		ID:              "mon",
+		Name:            "api",
+		Type:            "http",
+		Config:          `{"url":"https://example.com"}`,
+		NotificationIDs: []string{"ops"},
+		Tags:            []peekaping.MonitorTag{{TagID: "team", Value: "sre"}},
+		TagIDs:          []string{"team"},
+	})
+	r := &MonitorTagResource{client: client}
+
+	if err := r.setMonitorTag(ctx, "mon", "env", "prod"); err != nil {
+		t.Fatal(err)
+	}
+	upd := api.lastUpdate(t)
+	if got, _ := json.Marshal(upd["tags"]); string(got) != `[{"tag_id":"team","value":"sre"},{"tag_id":"env","value":"prod"}]` {
+		t.Errorf("attach sent tags %s", got)
+	}
+	if upd["config"] != `{"url":"https://example.com"}` || upd["name"] != "api" {
+		t.Errorf("attach dropped monitor settings: %v", upd)
+	}
+	if got, _ := json.Marshal(upd["notification_ids"]); string(got) != `["ops"]` {
+		t.Errorf("attach sent notification_ids %s", got)
+	}
+
+	if err := r.setMonitorTag(ctx, "mon", "env", "staging"); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := json.Marshal(api.lastUpdate(t)["tags"]); string(got) != `[{"tag_id":"team","value":"sre"},{"tag_id":"env","value":"staging"}]` {
+		t.Errorf("value update sent tags %s", got)
+	}
+
+	state := newResourceState(t, r, monitorTagResourceModel{
+		ID:        types.StringValue("mon/env"),
+		MonitorID: types.StringValue("mon"),
+		TagID:     types.StringValue("env"),
+		Value:     types.StringValue("staging"),
+	})
+	var resp resource.DeleteResponse
+	r.Delete(ctx, resource.DeleteRequest{State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("delete: %v", resp.Diagnostics)
+	}
+	upd = api.lastUpdate(t)
+	if got, _ := json.Marshal(upd["tags"]); string(got) != `[{"tag_id":"team","value":"sre"}]` {
+		t.Errorf("detach sent tags %s", got)
+	}
+	if got, _ := json.Marshal(upd["tag_ids"]); string(got) != `["team"]` {
+		t.Errorf("detach sent tag_ids %s", got)
+	}
+}
+
+// TestMonitorTagResourceMonitorGone tests that a monitor deleted outside of Terraform
+// removes the association on read and doesn't block destroy.
+func TestMonitorTagResourceMonitorGone(t *testing.T) {
+	ctx := context.Background()
+	api, client := newFakeMonitorAPI(t)
+	r := &MonitorTagResource{client: client}
+	state := newResourceState(t, r, monitorTagResourceModel{
+		ID:        types.StringValue("gone/env"),
+		MonitorID: types.StringValue("gone"),
+		TagID:     types.StringValue("env"),
+		Value:     types.StringNull(),
+	})
+
+	read := resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, &read)
+	if read.Diagnostics.HasError() || !read.State.Raw.IsNull() {
+		t.Errorf("expected the association to be removed, got %v, %v", read.Diagnostics, read.State.Raw)
+	}
+
+	var del resource.DeleteResponse
+	r.Delete(ctx, resource.DeleteRequest{State: state}, &del)
+	if del.Diagnostics.HasError() {
+		t.Errorf("delete: %v", del.Diagnostics)
+	}
+	if len(api.updates) != 0 {
+		t.Errorf("unexpected updates: %v", api.updates)
+	}
+}
+
+// TestMonitorNotificationResource tests attaching and detaching a notification
+// channel, and a monitor deleted outside of Terraform.
+func TestMonitorNotificationResource(t *testing.T) {
+	ctx := context.Background()
+	api, client := newFakeMonitorAPI(t, peekaping.Monitor{
+		ID:              "mon",
+		Name:            "api",
+		Type:            "http",
+		NotificationIDs: []string{"ops"},
+		TagIDs:          []string{"team"},
+	})
+	r := &MonitorNotificationResource{client: client}
+	model := monitorNotificationResourceModel{
+		ID:             types.StringUnknown(),
+		MonitorID:      types.StringValue("mon"),
+		NotificationID: types.StringValue("pager"),
+	}
+
+	plan := newResourceState(t, r, model)
+	create := resource.CreateResponse{State: plan}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(plan)}, &create)
+	if create.Diagnostics.HasError() {
+		t.Fatalf("create: %v", create.Diagnostics)
+	}
+	upd := api.lastUpdate(t)
+	if got, _ := json.Marshal(upd["notification_ids"]); string(got) != `["ops","pager"]` {
+		t.Errorf("attach sent notification_ids %s", got)
+	}
+	if got, _ := json.Marshal(upd["tag_ids"]); string(got) != `["team"]` {
+		t.Errorf("attach sent tag_ids %s", got)
+	}
+
+	var del resource.DeleteResponse
+	r.Delete(ctx, resource.DeleteRequest{State: create.State}, &del)
+	if del.Diagnostics.HasError() {
+		t.Fatalf("delete: %v", del.Diagnostics)
+	}
+	if got, _ := json.Marshal(api.lastUpdate(t)["notification_ids"]); string(got) != `["ops"]` {
+		t.Errorf("detach sent notification_ids %s", got)
+	}
+
+	api.mu.Lock()
+	delete(api.monitors, "mon")
+	updates := len(api.updates)
+	api.mu.Unlock()
+
+	read := resource.ReadResponse{State: create.State}
+	r.Read(ctx, resource.ReadRequest{State: create.State}, &read)
+	if read.Diagnostics.HasError() || !read.State.Raw.IsNull() {
+		t.Errorf("expected the association to be removed, got %v, %v", read.Diagnostics, read.State.Raw)
+	}
+	del = resource.DeleteResponse{}
+	r.Delete(ctx, resource.DeleteRequest{State: create.State}, &del)
+	if del.Diagnostics.HasError() {
+		t.Errorf("delete of a deleted monitor: %v", del.Diagnostics)
+	}
+	if len(api.updates) != updates {
+		t.Errorf("unexpected updates after the monitor was deleted: %v", api.updates[updates:])
+	}
+}
@@ -4,11 +4,18 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
 )
 
@@ -270,6 +277,199 @@ func TestMonitorResourceModel(t *testing.T) {
 	}
 }
 
+// TestMonitorTagsRoundTrip tests conversion between API tag assignments and the tags set.
+func TestMonitorTagsRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	// Servers that only return tag_ids yield tags without values
+	legacy := monitorTagsOf(&peekaping.Monitor{TagIDs: []string{"tag-1", "tag-2"}})
+	if len(legacy) != 2 || legacy[0].TagID != "tag-1" || legacy[0].Value != "" {
+		t.Fatalf("unexpected tags from tag_ids: %+v", legacy)
+	}
+
+	in := []peekaping.MonitorTag{{TagID: "env", Value: "prod"}, {TagID: "team"}}
+	set := monitorTagsToSet(in)
+	if len(set.Elements()) != 2 {
+		t.Fatalf("expected 2 set elements, got %d", len(set.Elements()))
+	}
+
+	out, diags := monitorTagsFromSet(ctx, set)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	got := map[string]string{}
+	for _, tag := range out {
+		got[tag.TagID] = tag.Value
+	}
+	if got["env"] != "prod" || got["team"] != "" || len(got) != 2 {
+		t.Errorf("unexpected round trip result: %+v", out)
+	}
+
+	var upd peekaping.MonitorUpdate
+	setMonitorUpdateTags(&upd, out)
+	if len(upd.TagIDs) != 2 || len(upd.Tags) != 2 {
+		t.Errorf("tag_ids and tags out of sync: %+v", upd)
+	}
+
+	// Detaching the last tag must send empty lists rather than omitting them.
+	setMonitorUpdateTags(&upd, nil)
+	body, err := json.Marshal(upd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(body), `"tag_ids":[]`) || !strings.Contains(string(body), `"tags":[]`) {
+		t.Errorf("empty tags not sent: %s", body)
+	}
+}
+
+// TestGeneratePushToken tests that generated push tokens are URL-safe and random.
+func TestGeneratePushToken(t *testing.T) {
+	seen := map[string]bool{}
+	for range 10 {
+		token, err := generatePushToken()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(token) != 32 || strings.Trim(token, "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_") != "" {
+			t.Errorf("token %q is not 32 URL-safe characters", token)
+		}
+		if seen[token] {
+			t.Errorf("token %q generated twice", token)
+		}
+		seen[token] = true
+	}
+}
+
+// TestResolvePushToken tests that tokens are generated only for push monitors that
+// leave the token to the provider.
+func TestResolvePushToken(t *testing.T) {
+	tests := []struct {
+		name, monitorType string
+		token             types.String
+		wantNull          bool
+		want              string
+	}{
+		{"push without token", "push", types.StringUnknown(), false, ""},
+		{"push with token", "push", types.StringValue("configured"), false, "configured"},
+		{"http without token", "http", types.StringUnknown(), true, ""},
+	}
+	for _, tt := range tests {
+		m := monitorResourceModel{Type: types.StringValue(tt.monitorType), PushToken: tt.token}
+		if err := resolvePushToken(&m); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		switch {
+		case tt.wantNull:
+			if !m.PushToken.IsNull() {
+				t.Errorf("%s: push_token = %s, want null", tt.name, m.PushToken)
+			}
+		case tt.want != "":
+			if m.PushToken.ValueString() != tt.want {
+				t.Errorf("%s: push_token = %s, want %q", tt.name, m.PushToken, tt.want)
+			}
+		case m.PushToken.IsUnknown() || m.PushToken.ValueString() == "":
+			t.Errorf("%s: no push_token generated", tt.name)
+		}
+	}
+}
+
+// TestMonitorModifyPlanPushToken tests that a generated push_token is kept across plans
+// and push_url is derived from it at plan time.
+func TestMonitorModifyPlanPushToken(t *testing.T) {
+	ctx := context.Background()
+	client := peekaping.New("https://peekaping.example.com")
+	r := &MonitorResource{client: client}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	object := func(set map[string]tftypes.Value) tftypes.Value {
+		vals := map[string]tftypes.Value{}
+		for name, typ := range objType.AttributeTypes {
+			vals[name] = tftypes.NewValue(typ, nil)
+		}
+		for name, v := range set {
+			vals[name] = v
+		}
+		return tftypes.NewValue(objType, vals)
+	}
+	str := func(s string) tftypes.Value { return tftypes.NewValue(tftypes.String, s) }
+	unknown := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	null := tftypes.NewValue(tftypes.String, nil)
+
+	tests := []struct {
+		name                    string
+		monitorType             string
+		configToken, stateToken tftypes.Value
+		wantToken, wantURL      string // "?" for unknown, "" for null
+	}{
+		{"create", "push", null, tftypes.Value{}, "?", "?"},
+		{"keep generated token", "push", null, str("generated"), "generated", client.PushURL("generated")},
+		{"configured token", "push", str("mine"), str("generated"), "mine", client.PushURL("mine")},
+		{"not a push monitor", "http", null, null, "", ""},
+	}
+	for _, tt := range tests {
+		config := object(map[string]tftypes.Value{"name": str("beat"), "type": str(tt.monitorType), "push_token": tt.configToken})
+		planToken := tt.configToken
+		if planToken.IsNull() {
+			planToken = unknown
+		}
+		plan := object(map[string]tftypes.Value{
+			"name": str("beat"), "type": str(tt.monitorType), "push_token": planToken, "push_url": unknown,
+		})
+		state := tftypes.NewValue(objType, nil)
+		if tt.stateToken.Type() != nil {
+			state = object(map[string]tftypes.Value{
+				"id": str("mon"), "name": str("beat"), "type": str(tt.monitorType), "push_token": tt.stateToken,
+			})
+		}
+
+		resp := resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: plan}}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{
+			Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config},
+			Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: plan},
+			State:  tfsdk.State{Schema: schemaResp.Schema, Raw: state},
+		}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%s: %v", tt.name, resp.Diagnostics)
+		}
+		for attr, want := range map[string]string{"push_token": tt.wantToken, "push_url": tt.wantURL} {
+			var got types.String
+			resp.Plan.GetAttribute(ctx, path.Root(attr), &got)
+			switch want {
+			case "?":
+				if !got.IsUnknown() {
+					t.Errorf("%s: %s = %s, want unknown", tt.name, attr, got)
+				}
+			case "":
+				if !got.IsNull() {
+					t.Errorf("%s: %s = %s, want null", tt.name, attr, got)
+				}
+			default:
+				if got.ValueString() != want {
+					t.Errorf("%s: %s = %s, want %q", tt.name, attr, got, want)
+				}
+			}
+		}
+	}
+}
+
+// TestMonitorPushURL tests building push_url from the provider endpoint and token.
+func TestMonitorPushURL(t *testing.T) {
+	r := &MonitorResource{client: peekaping.New("https://peekaping.example.com/")}
+	m := monitorResourceModel{Type: types.StringValue("push"), PushToken: types.StringValue("a b")}
+	if got := r.pushURL(&m); got.ValueString() != "https://peekaping.example.com/api/v1/push/a%20b" {
+		t.Errorf("pushURL() = %s", got)
+	}
+	m.Type = types.StringValue("http")
+	if got := r.pushURL(&m); !got.IsNull() {
+		t.Errorf("pushURL() of an http monitor = %s, want null", got)
+	}
+	m = monitorResourceModel{Type: types.StringValue("push"), PushToken: types.StringNull()}
+	if got := r.pushURL(&m); !got.IsNull() {
+		t.Errorf("pushURL() without a token = %s, want null", got)
+	}
+}
+
 // TestJSONNormalization tests JSON configuration normalization.
 func TestJSONNormalization(t *testing.T) {
 	tests := []struct {
@@ -884,3 +1084,77 @@ func TestMonitorConfigFieldValidation(t *testing.T) {
 		})
 	}
 }
+
+// TestMonitorUpgradeStateV0 tests migrating version 0 state, which had tag_ids, to
+// the tags set.
+func TestMonitorUpgradeStateV0(t *testing.T) {
+	ctx := context.Background()
+	r := &MonitorResource{}
+	upgrader := r.UpgradeState(ctx)[0]
+
+	var current resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &current)
+
+	upgrade := func(t *testing.T, old monitorResourceModelV0) monitorResourceModel {
+		t.Helper()
+		prior := tfsdk.State{Schema: *upgrader.PriorSchema, Raw: tftypes.NewValue(upgrader.PriorSchema.Type().TerraformType(ctx), nil)}
+		if diags := prior.Set(ctx, &old); diags.HasError() {
+			t.Fatalf("set v0 state: %v", diags)
+		}
+		resp := resource.UpgradeStateResponse{
+			State: tfsdk.State{Schema: current.Schema, Raw: tftypes.NewValue(current.Schema.Type().TerraformType(ctx), nil)},
+		}
+		upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{State: &prior}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("upgrade: %v", resp.Diagnostics)
+		}
+		var got monitorResourceModel
+		if diags := resp.State.Get(ctx, &got); diags.HasError() {
+			t.Fatalf("get upgraded state: %v", diags)
+		}
+		return got
+	}
+
+	old := monitorResourceModelV0{
+		ID:              types.StringValue("mon-1"),
+		Name:            types.StringValue("api"),
+		Type:            types.StringValue("http"),
+		Config:          jsontypes.NewNormalizedValue(`{"url":"https://example.com"}`),
+		Interval:        types.Int64Value(60),
+		Active:          types.BoolValue(true),
+		Timeout:         types.Int64Value(30),
+		MaxRetries:      types.Int64Value(3),
+		RetryInterval:   types.Int64Value(60),
+		ResendInterval:  types.Int64Value(10),
+		ProxyID:         types.StringNull(),
+		PushToken:       types.StringNull(),
+		NotificationIDs: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("ops")}),
+		TagIDs:          types.ListValueMust(types.StringType, []attr.Value{types.StringValue("env"), types.StringValue("team")}),
+		ManageNotifs:    types.BoolValue(false),
+		ManageTags:      types.BoolValue(true),
+		Status:          types.Int64Value(1),
+		CreatedAt:       types.StringValue("2025-01-01T00:00:00Z"),
+		UpdatedAt:       types.StringValue("2025-01-02T00:00:00Z"),
+	}
+	got := upgrade(t, old)
+
+	want := monitorTagsToSet([]peekaping.MonitorTag{{TagID: "env"}, {TagID: "team"}})
+	if !got.Tags.Equal(want) {
+		t.Errorf("tags = %v, want %v", got.Tags, want)
+	}
+	if got.ManageNotifs.ValueBool() || !got.ManageTags.ValueBool() {
+		t.Errorf("manage flags not kept: manage_notifications=%v manage_tags=%v", got.ManageNotifs, got.ManageTags)
+	}
+	if got.ID.ValueString() != "mon-1" || got.Config.ValueString() != old.Config.ValueString() || !got.NotificationIDs.Equal(old.NotificationIDs) {
+		t.Errorf("unchanged attributes not kept: %+v", got)
+	}
+	if !got.PushURL.IsNull() {
+		t.Errorf("push_url = %v, want null until the next refresh", got.PushURL)
+	}
+
+	old.TagIDs = types.ListNull(types.StringType)
+	old.ManageTags = types.BoolNull()
+	if got := upgrade(t, old); !got.Tags.IsNull() || !got.ManageTags.IsNull() {
+		t.Errorf("null tag_ids became tags %v, manage_tags %v", got.Tags, got.ManageTags)
+	}
+}
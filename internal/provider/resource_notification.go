@@ -23,6 +23,7 @@ import (
 
 var _ resource.Resource = &NotificationResource{}
 var _ resource.ResourceWithImportState = &NotificationResource{}
+var _ resource.ResourceWithIdentity = &NotificationResource{}
 
 type NotificationResource struct {
 	client *peekaping.Client
@@ -206,6 +207,10 @@ func (r *NotificationResource) Schema(_ context.Context, _ resource.SchemaReques
 	}
 }
 
+func (r *NotificationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *NotificationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -250,6 +255,7 @@ func (r *NotificationResource) Create(ctx context.Context, req resource.CreateRe
 	}
 	setModelFromNotification(&plan, n)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, plan.ID.ValueString())...)
 }
 
 func (r *NotificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -277,6 +283,7 @@ func (r *NotificationResource) Read(ctx context.Context, req resource.ReadReques
 	// Use direct field mapping for Read operations
 	setModelFromNotification(&state, n)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, state.ID.ValueString())...)
 }
 
 func (r *NotificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -316,6 +323,7 @@ func (r *NotificationResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 	setModelFromNotification(&plan, n)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, plan.ID.ValueString())...)
 }
 
 func (r *NotificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -332,9 +340,28 @@ func (r *NotificationResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *NotificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID, diags := importIDFromRequest(ctx, req, r.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := importLookup(importID, "name", func() ([]peekaping.Notification, error) {
+		list, err := r.client.ListNotifications(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	}, func(x *peekaping.Notification) (string, string) { return x.Name, x.ID }, "notification")
+	if err != nil {
+		resp.Diagnostics.AddError("import failed", err.Error())
+		return
+	}
+
 	var state notificationResourceModel
-	state.ID = types.StringValue(req.ID)
+	state.ID = types.StringValue(id)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, id)...)
 }
 
 func setModelFromNotification(m *notificationResourceModel, from *peekaping.Notification) {
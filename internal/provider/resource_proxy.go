@@ -8,7 +8,10 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -22,6 +25,8 @@ import (
 
 var _ resource.Resource = &ProxyResource{}
 var _ resource.ResourceWithImportState = &ProxyResource{}
+var _ resource.ResourceWithIdentity = &ProxyResource{}
+var _ resource.ResourceWithValidateConfig = &ProxyResource{}
 
 type ProxyResource struct {
 	client *peekaping.Client
@@ -208,6 +213,10 @@ type proxyResourceModel struct {
 	Password    types.String `tfsdk:"password"`
 	CreatedDate types.String `tfsdk:"created_date"`
 	UpdatedAt   types.String `tfsdk:"updated_at"`
+
+	VerifyOnApply  types.Bool   `tfsdk:"verify_on_apply"`
+	VerifyTarget   types.String `tfsdk:"verify_target"`
+	LastVerifiedAt types.String `tfsdk:"last_verified_at"`
 }
 
 func (r *ProxyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -274,10 +283,45 @@ func (r *ProxyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Computed:    true,
 				Description: "Last update timestamp",
 			},
+			"verify_on_apply": schema.BoolAttribute{
+				Optional: true,
+				Description: "Open a connection through the proxy to verify_target before creating or updating it, " +
+					"and fail the apply if that doesn't work. Runs from the machine running Terraform",
+			},
+			"verify_target": schema.StringAttribute{
+				Optional:    true,
+				Description: "A host:port or http(s) URL that monitors reach through the proxy, used by verify_on_apply",
+				Validators: []validator.String{
+					proxyVerifyTargetValidator{},
+				},
+			},
+			"last_verified_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the proxy last passed verification (RFC 3339)",
+			},
 		},
 	}
 }
 
+func (r *ProxyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config proxyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if config.VerifyOnApply.ValueBool() && config.VerifyTarget.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("verify_target"),
+			"Missing Verify Target",
+			"verify_target must be set when verify_on_apply is true, e.g. to a URL one of the proxied monitors checks.",
+		)
+	}
+}
+
+func (r *ProxyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *ProxyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -321,6 +365,11 @@ func (r *ProxyResource) Create(ctx context.Context, req resource.CreateRequest,
 		in.Password = plan.Password.ValueString()
 	}
 
+	resp.Diagnostics.Append(r.verify(ctx, &plan, types.StringNull())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	p, err := r.client.CreateProxy(ctx, in)
 	if err != nil {
 		resp.Diagnostics.AddError("create proxy failed", err.Error())
@@ -328,6 +377,7 @@ func (r *ProxyResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 	setModelFromProxy(&plan, p)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, plan.ID.ValueString())...)
 }
 
 func (r *ProxyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -344,6 +394,7 @@ func (r *ProxyResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 	setModelFromProxy(&state, p)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, state.ID.ValueString())...)
 }
 
 func (r *ProxyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -386,6 +437,11 @@ func (r *ProxyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		upd.Password = &v
 	}
 
+	resp.Diagnostics.Append(r.verify(ctx, &plan, state.LastVerifiedAt)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Use state.ID instead of plan.ID
 	p, err := r.client.UpdateProxy(ctx, state.ID.ValueString(), upd)
 	if err != nil {
@@ -394,6 +450,39 @@ func (r *ProxyResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 	setModelFromProxy(&plan, p)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, plan.ID.ValueString())...)
+}
+
+// verify checks the planned proxy against verify_target if verify_on_apply is set and records last_verified_at,
+// keeping previous otherwise.
+func (r *ProxyResource) verify(ctx context.Context, plan *proxyResourceModel, previous types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	plan.LastVerifiedAt = previous
+	if !plan.VerifyOnApply.ValueBool() {
+		return diags
+	}
+
+	target, err := proxyVerifyTarget(plan.VerifyTarget.ValueString())
+	if err != nil {
+		diags.AddError("verify proxy failed", err.Error())
+		return diags
+	}
+	check := proxyCheck{
+		Protocol: plan.Protocol.ValueString(),
+		Host:     plan.Host.ValueString(),
+		Port:     int(plan.Port.ValueInt64()),
+		Target:   target,
+	}
+	if plan.Auth.ValueBool() {
+		check.Username = plan.Username.ValueString()
+		check.Password = plan.Password.ValueString()
+	}
+	if err := check.verify(ctx); err != nil {
+		diags.AddAttributeError(path.Root("host"), "verify proxy failed", err.Error())
+		return diags
+	}
+	plan.LastVerifiedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	return diags
 }
 
 func (r *ProxyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -410,9 +499,28 @@ func (r *ProxyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *ProxyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID, diags := importIDFromRequest(ctx, req, r.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := proxyImportLookup(importID, func() ([]peekaping.Proxy, error) {
+		list, err := r.client.ListProxies(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("import failed", err.Error())
+		return
+	}
+
 	var state proxyResourceModel
-	state.ID = types.StringValue(req.ID)
+	state.ID = types.StringValue(id)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, id)...)
 }
 
 func setModelFromProxy(m *proxyResourceModel, from *peekaping.Proxy) {
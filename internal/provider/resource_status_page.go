@@ -7,9 +7,12 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -17,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -25,6 +29,9 @@ import (
 
 var _ resource.Resource = &StatusPageResource{}
 var _ resource.ResourceWithImportState = &StatusPageResource{}
+var _ resource.ResourceWithIdentity = &StatusPageResource{}
+var _ resource.ResourceWithValidateConfig = &StatusPageResource{}
+var _ resource.ResourceWithModifyPlan = &StatusPageResource{}
 
 // normalizeMonitorIDsPlanModifier uses API's order from state for updates.
 type normalizeMonitorIDsPlanModifier struct{}
@@ -266,8 +273,7 @@ func (v statusPageFooterTextValidator) ValidateString(ctx context.Context, req v
 		return
 	}
 
-	footerText := req.ConfigValue.ValueString()
-	if len(footerText) > 200 {
+	if err := checkFooterText(req.ConfigValue.ValueString()); err != nil {
 		resp.Diagnostics.AddAttributeError(
 			req.Path,
 			"Invalid Footer Text",
@@ -289,12 +295,20 @@ type statusPageResourceModel struct {
 	Description           types.String   `tfsdk:"description"`
 	Slug                  types.String   `tfsdk:"slug"`
 	Domains               []types.String `tfsdk:"domains"`
+	DNSTarget             types.String   `tfsdk:"dns_target"`
+	DNSRecords            types.List     `tfsdk:"dns_records"`
 	MonitorIDs            types.List     `tfsdk:"monitor_ids"`
 	Published             types.Bool     `tfsdk:"published"`
 	Theme                 types.String   `tfsdk:"theme"`
 	Icon                  types.String   `tfsdk:"icon"`
+	IconFile              types.String   `tfsdk:"icon_file"`
+	IconBase64            types.String   `tfsdk:"icon_base64"`
+	IconHash              types.String   `tfsdk:"icon_hash"`
 	FooterText            types.String   `tfsdk:"footer_text"`
+	FooterTextFile        types.String   `tfsdk:"footer_text_file"`
 	CustomCSS             types.String   `tfsdk:"custom_css"`
+	CustomCSSFile         types.String   `tfsdk:"custom_css_file"`
+	ContentHash           types.String   `tfsdk:"content_hash"`
 	GoogleAnalyticsTagID  types.String   `tfsdk:"google_analytics_tag_id"`
 	AutoRefreshInterval   types.Int64    `tfsdk:"auto_refresh_interval"`
 	SearchEngineIndex     types.Bool     `tfsdk:"search_engine_index"`
@@ -302,6 +316,9 @@ type statusPageResourceModel struct {
 	ShowPoweredBy         types.Bool     `tfsdk:"show_powered_by"`
 	ShowTags              types.Bool     `tfsdk:"show_tags"`
 	Password              types.String   `tfsdk:"password"`
+	PasswordWO            types.String   `tfsdk:"password_wo"`
+	PasswordHash          types.String   `tfsdk:"password_hash"`
+	PasswordProtected     types.Bool     `tfsdk:"password_protected"`
 	CreatedAt             types.String   `tfsdk:"created_at"`
 	UpdatedAt             types.String   `tfsdk:"updated_at"`
 }
@@ -343,7 +360,28 @@ func (r *StatusPageResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			"domains": schema.ListAttribute{
 				Optional:    true,
 				ElementType: types.StringType,
-				Description: "List of custom domains for the status page",
+				Description: "List of custom domains for the status page, as hostnames without scheme, port or path",
+				Validators: []validator.List{
+					statusPageDomainsValidator{},
+				},
+			},
+			"dns_target": schema.StringAttribute{
+				Optional:    true,
+				Description: "Hostname or IP address the custom domains should point at, i.e. where the status page is served. Defaults to the host of the provider endpoint",
+				Validators: []validator.String{
+					statusPageDNSTargetValidator{},
+				},
+			},
+			"dns_records": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "DNS records to create so the custom domains reach the status page",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type":  schema.StringAttribute{Computed: true, Description: "Record type: CNAME, or A/AAAA if the target is an IP address"},
+						"name":  schema.StringAttribute{Computed: true, Description: "Record name, the domain in ASCII (punycode) form"},
+						"value": schema.StringAttribute{Computed: true, Description: "Record value, dns_target or the host of the provider endpoint"},
+					},
+				},
 			},
 			"monitor_ids": schema.ListAttribute{
 				Optional:    true,
@@ -367,22 +405,47 @@ func (r *StatusPageResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			},
 			"icon": schema.StringAttribute{
 				Optional:    true,
-				Description: "Status page icon",
+				Description: "Status page icon, as a URL or data URL. Conflicts with icon_file and icon_base64",
+			},
+			"icon_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a local image to upload as the icon (PNG, JPEG, GIF, WebP, ICO or SVG, at most 1 MiB)",
+			},
+			"icon_base64": schema.StringAttribute{
+				Optional:    true,
+				Description: "Base64-encoded image or data URL to upload as the icon, e.g. from filebase64()",
+			},
+			"icon_hash": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA-256 of the uploaded icon image. The icon is only re-uploaded when this changes",
 			},
 			"footer_text": schema.StringAttribute{
 				Optional:    true,
-				Description: "Footer text for the status page",
+				Description: "Footer text for the status page. Conflicts with footer_text_file",
 				Validators: []validator.String{
 					statusPageFooterTextValidator{},
 				},
 			},
+			"footer_text_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a local file with the footer text. Its content is tracked by content_hash rather than stored in state",
+			},
 			"custom_css": schema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
-				Description: "Custom CSS for the status page",
+				Description: "Custom CSS for the status page. Conflicts with custom_css_file",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"custom_css_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a local CSS file for the status page. Its content is tracked by content_hash rather than stored in state",
+			},
+			"content_hash": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA-256 of the custom CSS and footer text. Changes to either, including from files, show up as a diff here",
+			},
 			"google_analytics_tag_id": schema.StringAttribute{
 				Computed:    true,
 				Description: "Google Analytics tag ID",
@@ -426,11 +489,26 @@ func (r *StatusPageResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"password": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password protecting the status page. Never read back from the server. Conflicts with password_wo",
+			},
+			"password_wo": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "Write-only password protecting the status page, kept out of plan and state (Terraform 1.11+). Conflicts with password",
+			},
+			"password_hash": schema.StringAttribute{
 				Computed:    true,
 				Sensitive:   true,
-				Description: "Password for the status page (if protected)",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
+				Description: "Salted Argon2id hash of the configured password, used to detect password changes",
+			},
+			"password_protected": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the status page is protected by a password, as reported by the server or, when it omits the password, as last applied",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"created_at": schema.StringAttribute{
@@ -448,6 +526,10 @@ func (r *StatusPageResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+func (r *StatusPageResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *StatusPageResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -463,6 +545,299 @@ func (r *StatusPageResource) Configure(_ context.Context, req resource.Configure
 	r.client = client
 }
 
+func (r *StatusPageResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data statusPageResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var set []string
+	for name, v := range map[string]types.String{"icon": data.Icon, "icon_file": data.IconFile, "icon_base64": data.IconBase64} {
+		if !v.IsNull() {
+			set = append(set, name)
+		}
+	}
+	// Icon problems are reported alongside the other checks rather than stopping them;
+	// unknown icon values are checked once known.
+	switch {
+	case len(set) > 1:
+		slices.Sort(set)
+		resp.Diagnostics.AddAttributeError(
+			path.Root(set[1]),
+			"Conflicting Attributes",
+			fmt.Sprintf("Only one of icon, icon_file and icon_base64 can be set, got %s.", strings.Join(set, " and ")),
+		)
+	case data.IconFile.IsUnknown() || data.IconBase64.IsUnknown():
+	default:
+		if _, err := loadStatusPageIcon(data.IconFile, data.IconBase64); err != nil {
+			attr := "icon_file"
+			if data.IconFile.IsNull() {
+				attr = "icon_base64"
+			}
+			resp.Diagnostics.AddAttributeError(path.Root(attr), "Invalid Icon", err.Error())
+		}
+	}
+
+	checkStatusPageContent(&data, &resp.Diagnostics)
+
+	if !data.Password.IsNull() && !data.PasswordWO.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password_wo"),
+			"Conflicting Attributes",
+			"Only one of password and password_wo can be set.",
+		)
+	}
+}
+
+// checkStatusPageContent rejects conflicting or unreadable custom CSS and footer text,
+// and footer text over the length limit.
+func checkStatusPageContent(data *statusPageResourceModel, diags *diag.Diagnostics) {
+	for _, c := range []struct {
+		inline, file types.String
+		name         string
+		check        func(string) error
+	}{
+		// Peekaping documents no size limit for custom CSS, so there is none to check here
+		{data.CustomCSS, data.CustomCSSFile, "custom_css", nil},
+		{data.FooterText, data.FooterTextFile, "footer_text", checkFooterText},
+	} {
+		if !c.inline.IsNull() && !c.file.IsNull() {
+			diags.AddAttributeError(
+				path.Root(c.name+"_file"),
+				"Conflicting Attributes",
+				fmt.Sprintf("Only one of %s and %s_file can be set.", c.name, c.name),
+			)
+			continue
+		}
+		// Inline footer text is already checked by statusPageFooterTextValidator.
+		if c.file.IsNull() && c.name == "footer_text" {
+			continue
+		}
+		content, err := resolveContent(c.inline, c.file)
+		if err == nil && (content.IsNull() || content.IsUnknown() || c.check == nil) {
+			continue
+		}
+		if err == nil {
+			err = c.check(content.ValueString())
+		}
+		if err != nil {
+			name := c.name
+			if !c.file.IsNull() {
+				name += "_file"
+			}
+			diags.AddAttributeError(path.Root(name), "Invalid Content", err.Error())
+		}
+	}
+}
+
+func (r *StatusPageResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan statusPageResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state *statusPageResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &statusPageResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	r.planIconHash(ctx, &plan, resp)
+	r.planDomains(ctx, &plan, state, resp)
+	r.planContent(ctx, req, &plan, state, resp)
+	r.planPassword(ctx, req, state, resp)
+}
+
+// planPassword keeps password_hash while the configured password still matches it and the
+// server still reports a password, so the password causes no diff. Otherwise the password
+// is sent again, getting a new hash at apply time, and password_protected is left to the
+// server's response.
+func (r *StatusPageResource) planPassword(ctx context.Context, req resource.ModifyPlanRequest, state *statusPageResourceModel, resp *resource.ModifyPlanResponse) {
+	var password, passwordWO types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &passwordWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	protected := state != nil && state.PasswordProtected.ValueBool()
+	configured := configuredPassword(password, passwordWO)
+	hash := types.StringUnknown()
+	switch {
+	case configured.IsNull():
+		hash = types.StringNull()
+		if !protected {
+			break
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("password_protected"), types.BoolUnknown())...)
+	case !configured.IsUnknown() && protected && statusPagePasswordMatches(state.PasswordHash, configured.ValueString()):
+		hash = state.PasswordHash
+	default:
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("password_protected"), types.BoolUnknown())...)
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("password_hash"), hash)...)
+}
+
+// statusPagePasswordProtected reports whether the status page has a password. The server
+// stores passwords hashed, so password and password_hash are kept from the configuration.
+// A password in the response proves protection, but the server may omit it, so without
+// one the last applied password_hash, or else the prior password_protected, is kept.
+func statusPagePasswordProtected(from *peekaping.StatusPage, currentState *statusPageResourceModel) types.Bool {
+	switch {
+	case from.Password != "":
+		return types.BoolValue(true)
+	case currentState == nil:
+		return types.BoolValue(false)
+	case !currentState.PasswordHash.IsNull() && !currentState.PasswordHash.IsUnknown():
+		return types.BoolValue(true)
+	case !currentState.PasswordProtected.IsNull() && !currentState.PasswordProtected.IsUnknown():
+		return currentState.PasswordProtected
+	}
+	return types.BoolValue(false)
+}
+
+// applyPassword returns the password to send and sets password_hash, or returns nil
+// if the password is unchanged. Removing the password sends an empty one.
+func applyPassword(ctx context.Context, config tfsdk.Config, plan, state *statusPageResourceModel) (*string, diag.Diagnostics) {
+	var passwordWO types.String
+	diags := config.GetAttribute(ctx, path.Root("password_wo"), &passwordWO)
+	if diags.HasError() {
+		return nil, diags
+	}
+	configured := configuredPassword(plan.Password, passwordWO)
+	if configured.IsNull() {
+		plan.PasswordHash = types.StringNull()
+		if state != nil && state.PasswordProtected.ValueBool() {
+			return new(string), diags
+		}
+		return nil, diags
+	}
+	if !plan.PasswordHash.IsUnknown() {
+		return nil, diags
+	}
+	v := configured.ValueString()
+	plan.PasswordHash = types.StringValue(newStatusPagePasswordHash(v))
+	return &v, diags
+}
+
+// planContent plans content_hash from the custom CSS and footer text, loading files, and
+// summarizes changes against the last applied content.
+func (r *StatusPageResource) planContent(ctx context.Context, req resource.ModifyPlanRequest, plan, state *statusPageResourceModel, resp *resource.ModifyPlanResponse) {
+	var configCSS types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("custom_css"), &configCSS)...)
+	switch {
+	case !plan.CustomCSSFile.IsNull():
+		// File contents are tracked by content_hash, not stored in custom_css.
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("custom_css"), types.StringNull())...)
+	case configCSS.IsNull():
+		// Without custom_css or custom_css_file the CSS is cleared rather than kept from state.
+		plan.CustomCSS = types.StringValue("")
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("custom_css"), plan.CustomCSS)...)
+	}
+	content, known, err := resolveStatusPageContent(plan)
+	if err != nil {
+		resp.Diagnostics.AddError("load status page content failed", err.Error())
+		return
+	}
+	if !known {
+		return
+	}
+	hash := content.hash()
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_hash"), hash)...)
+
+	if state == nil || state.ContentHash.ValueString() == hash {
+		return
+	}
+	prev, ok := getPrivateContent(ctx, req.Private)
+	if !ok {
+		return
+	}
+	var changes []string
+	if prev.CustomCSS != content.CustomCSS {
+		changes = append(changes, summarizeContentChange("custom_css", prev.CustomCSS, content.CustomCSS))
+	}
+	if prev.FooterText != content.FooterText {
+		changes = append(changes, summarizeContentChange("footer_text", prev.FooterText, content.FooterText))
+	}
+	if len(changes) > 0 {
+		resp.Diagnostics.AddWarning("Status Page Content Changes", strings.Join(changes, "\n"))
+	}
+}
+
+// resolveStatusPageContent returns the custom CSS and footer text to send, loading files.
+// It returns false if either is unknown.
+func resolveStatusPageContent(plan *statusPageResourceModel) (statusPageContent, bool, error) {
+	css, err := resolveContent(plan.CustomCSS, plan.CustomCSSFile)
+	if err != nil {
+		return statusPageContent{}, false, err
+	}
+	footer, err := resolveContent(plan.FooterText, plan.FooterTextFile)
+	if err != nil {
+		return statusPageContent{}, false, err
+	}
+	content := statusPageContent{CustomCSS: css.ValueString(), FooterText: footer.ValueString()}
+	return content, !css.IsUnknown() && !footer.IsUnknown(), nil
+}
+
+// planIconHash plans icon_hash from the configured icon, so a changed image file shows
+// as a diff even when its path stays the same.
+func (r *StatusPageResource) planIconHash(ctx context.Context, plan *statusPageResourceModel, resp *resource.ModifyPlanResponse) {
+	if plan.Icon.IsUnknown() || plan.IconFile.IsUnknown() || plan.IconBase64.IsUnknown() {
+		return
+	}
+
+	hash := iconHashFromDataURL(plan.Icon.ValueString())
+	icon, err := loadStatusPageIcon(plan.IconFile, plan.IconBase64)
+	if err != nil {
+		resp.Diagnostics.AddError("load status page icon failed", err.Error())
+		return
+	}
+	if icon != nil {
+		hash = types.StringValue(icon.hash())
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("icon_hash"), hash)...)
+}
+
+// planDomains plans dns_records and rejects new domains already used by another status page.
+func (r *StatusPageResource) planDomains(ctx context.Context, plan, state *statusPageResourceModel, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || slices.ContainsFunc(plan.Domains, types.String.IsUnknown) {
+		return
+	}
+	domains := toStrSliceFromStringSlice(plan.Domains)
+	if !plan.DNSTarget.IsUnknown() {
+		records := statusPageDNSRecords(domains, statusPageDNSTarget(plan.DNSTarget, r.client.Endpoint))
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("dns_records"), records)...)
+	}
+
+	var selfID string
+	var added []string
+	for _, d := range domains {
+		if state == nil || !slices.Contains(toStrSliceFromStringSlice(state.Domains), d) {
+			added = append(added, d)
+		}
+	}
+	if state != nil {
+		selfID = state.ID.ValueString()
+	}
+	if len(added) == 0 {
+		return
+	}
+	pages, err := r.client.ListStatusPages(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("list status pages failed", err.Error())
+		return
+	}
+	for _, conflict := range statusPageDomainConflicts(pages.Items, selfID, added) {
+		resp.Diagnostics.AddAttributeError(path.Root("domains"), "Domain Already In Use", conflict)
+	}
+}
+
 func (r *StatusPageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan statusPageResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -489,6 +864,29 @@ func (r *StatusPageResource) Create(ctx context.Context, req resource.CreateRequ
 		Icon:        plan.Icon.ValueString(),
 		FooterText:  plan.FooterText.ValueString(),
 	}
+	icon, err := loadStatusPageIcon(plan.IconFile, plan.IconBase64)
+	if err != nil {
+		resp.Diagnostics.AddError("load status page icon failed", err.Error())
+		return
+	}
+	if icon != nil {
+		in.Icon = icon.dataURL()
+	}
+	content, _, err := resolveStatusPageContent(&plan)
+	if err != nil {
+		resp.Diagnostics.AddError("load status page content failed", err.Error())
+		return
+	}
+	in.CustomCSS = content.CustomCSS
+	in.FooterText = content.FooterText
+	password, diags := applyPassword(ctx, req.Config, &plan, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if password != nil {
+		in.Password = *password
+	}
 
 	sp, err := r.client.CreateStatusPage(ctx, in)
 	if err != nil {
@@ -496,9 +894,12 @@ func (r *StatusPageResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 	setModelFromStatusPageWithState(&plan, sp, &plan)
-	// Preserve the plan's monitor_ids, custom_css, google_analytics_tag_id, password
+	plan.DNSRecords = statusPageDNSRecords(toStrSliceFromStringSlice(plan.Domains), statusPageDNSTarget(plan.DNSTarget, r.client.Endpoint))
+	resp.Diagnostics.Append(setPrivateContent(ctx, resp.Private, statusPageContent{CustomCSS: sp.CustomCSS, FooterText: sp.FooterText})...)
+	// Preserve the plan's monitor_ids, custom_css, google_analytics_tag_id, password_hash
 	// and boolean flags to maintain Terraform state consistency
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, plan.ID.ValueString())...)
 }
 
 func (r *StatusPageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -514,7 +915,10 @@ func (r *StatusPageResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 	setModelFromStatusPageWithState(&state, sp, &state)
+	state.DNSRecords = statusPageDNSRecords(toStrSliceFromStringSlice(state.Domains), statusPageDNSTarget(state.DNSTarget, r.client.Endpoint))
+	resp.Diagnostics.Append(setPrivateContent(ctx, resp.Private, statusPageContent{CustomCSS: sp.CustomCSS, FooterText: sp.FooterText})...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, state.ID.ValueString())...)
 }
 
 func (r *StatusPageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -555,19 +959,37 @@ func (r *StatusPageResource) Update(ctx context.Context, req resource.UpdateRequ
 		v := plan.Theme.ValueString()
 		upd.Theme = &v
 	}
-	if !plan.Icon.IsNull() {
-		v := plan.Icon.ValueString()
-		upd.Icon = &v
+	icon, err := statusPageIconUpdate(&plan, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("load status page icon failed", err.Error())
+		return
 	}
+	upd.Icon = icon
 	if !plan.FooterText.IsNull() {
 		v := plan.FooterText.ValueString()
 		upd.FooterText = &v
 	}
-	// custom_css, google_analytics_tag_id, monitor_ids, password, and boolean flags
+	// Custom CSS and footer text are only sent when their content changed.
+	if !plan.ContentHash.Equal(state.ContentHash) {
+		content, _, err := resolveStatusPageContent(&plan)
+		if err != nil {
+			resp.Diagnostics.AddError("load status page content failed", err.Error())
+			return
+		}
+		upd.CustomCSS = &content.CustomCSS
+		upd.FooterText = &content.FooterText
+	}
+	password, diags := applyPassword(ctx, req.Config, &plan, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	upd.Password = password
+	// google_analytics_tag_id, monitor_ids and boolean flags
 	// are computed by the API, not updated by user
 
 	// Use state.ID instead of plan.ID
-	_, err := r.client.UpdateStatusPage(ctx, state.ID.ValueString(), upd)
+	_, err = r.client.UpdateStatusPage(ctx, state.ID.ValueString(), upd)
 	if err != nil {
 		resp.Diagnostics.AddError("update status page failed", err.Error())
 		return
@@ -582,7 +1004,34 @@ func (r *StatusPageResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	setModelFromStatusPageWithState(&plan, fullSp, &plan)
+	plan.DNSRecords = statusPageDNSRecords(toStrSliceFromStringSlice(plan.Domains), statusPageDNSTarget(plan.DNSTarget, r.client.Endpoint))
+	resp.Diagnostics.Append(setPrivateContent(ctx, resp.Private, statusPageContent{CustomCSS: fullSp.CustomCSS, FooterText: fullSp.FooterText})...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, plan.ID.ValueString())...)
+}
+
+// statusPageIconUpdate returns the icon to send on update, or nil to keep the server's.
+// Uploaded icons are only sent when the image changed, and removing every icon attribute
+// from the configuration sends an empty icon to clear it.
+func statusPageIconUpdate(plan, state *statusPageResourceModel) (*string, error) {
+	if !plan.IconHash.Equal(state.IconHash) {
+		icon, err := loadStatusPageIcon(plan.IconFile, plan.IconBase64)
+		if err != nil {
+			return nil, err
+		}
+		if icon != nil {
+			v := icon.dataURL()
+			return &v, nil
+		}
+	}
+	if !plan.Icon.IsNull() {
+		v := plan.Icon.ValueString()
+		return &v, nil
+	}
+	if plan.IconFile.IsNull() && plan.IconBase64.IsNull() && (!state.Icon.IsNull() || !state.IconHash.IsNull()) {
+		return new(string), nil
+	}
+	return nil, nil
 }
 
 func (r *StatusPageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -599,9 +1048,36 @@ func (r *StatusPageResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *StatusPageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID, diags := importIDFromRequest(ctx, req, r.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list := func() ([]peekaping.StatusPage, error) {
+		list, err := r.client.ListStatusPages(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	}
+	id, err := importLookup(importID, "slug", list, func(x *peekaping.StatusPage) (string, string) { return x.Slug, x.ID }, "status page")
+	if err != nil {
+		resp.Diagnostics.AddError("import failed", err.Error())
+		return
+	}
+	id, err = importLookup(id, "name", list, func(x *peekaping.StatusPage) (string, string) { return x.Title, x.ID }, "status page")
+	if err != nil {
+		resp.Diagnostics.AddError("import failed", err.Error())
+		return
+	}
+
 	var state statusPageResourceModel
-	state.ID = types.StringValue(req.ID)
+	state.ID = types.StringValue(id)
+	state.MonitorIDs = types.ListNull(types.StringType)
+	state.DNSRecords = types.ListNull(types.ObjectType{AttrTypes: statusPageDNSRecordAttrTypes})
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, id)...)
 }
 
 func setModelFromStatusPageWithState(m *statusPageResourceModel, from *peekaping.StatusPage, currentState *statusPageResourceModel) {
@@ -623,18 +1099,30 @@ func setModelFromStatusPageWithState(m *statusPageResourceModel, from *peekaping
 	} else {
 		m.Theme = types.StringNull()
 	}
-	if from.Icon != "" {
+	// Uploaded icons are tracked by icon_hash rather than storing the image in state.
+	if !m.IconFile.IsNull() || !m.IconBase64.IsNull() {
+		m.Icon = types.StringNull()
+	} else if from.Icon != "" {
 		m.Icon = types.StringValue(from.Icon)
 	} else {
 		m.Icon = types.StringNull()
 	}
-	if from.FooterText != "" {
+	if hash := iconHashFromDataURL(from.Icon); !hash.IsNull() || from.Icon == "" || m.IconHash.IsUnknown() {
+		m.IconHash = hash
+	}
+	// Content loaded from files is tracked by content_hash rather than stored in state.
+	if from.FooterText != "" && m.FooterTextFile.IsNull() {
 		m.FooterText = types.StringValue(from.FooterText)
 	} else {
 		m.FooterText = types.StringNull()
 	}
+	if m.CustomCSSFile.IsNull() {
+		m.CustomCSS = types.StringValue(from.CustomCSS)
+	} else {
+		m.CustomCSS = types.StringNull()
+	}
+	m.ContentHash = types.StringValue(statusPageContent{CustomCSS: from.CustomCSS, FooterText: from.FooterText}.hash())
 	// Always set computed fields from API response
-	m.CustomCSS = types.StringValue(from.CustomCSS)
 	m.GoogleAnalyticsTagID = types.StringValue(from.GoogleAnalyticsTagID)
 	m.AutoRefreshInterval = types.Int64Value(int64(from.AutoRefreshInterval))
 	// Always set computed boolean fields from API response
@@ -642,12 +1130,7 @@ func setModelFromStatusPageWithState(m *statusPageResourceModel, from *peekaping
 	m.ShowCertificateExpiry = types.BoolValue(from.ShowCertificateExpiry)
 	m.ShowPoweredBy = types.BoolValue(from.ShowPoweredBy)
 	m.ShowTags = types.BoolValue(from.ShowTags)
-	// Always set computed password field from API response
-	if from.Password != "" {
-		m.Password = types.StringValue(from.Password)
-	} else {
-		m.Password = types.StringNull()
-	}
+	m.PasswordProtected = statusPagePasswordProtected(from, currentState)
 	// Always set computed timestamp fields from API response
 	m.CreatedAt = types.StringValue(from.CreatedAt)
 	m.UpdatedAt = types.StringValue(from.UpdatedAt)
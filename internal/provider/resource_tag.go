@@ -21,6 +21,7 @@ import (
 
 var _ resource.Resource = &TagResource{}
 var _ resource.ResourceWithImportState = &TagResource{}
+var _ resource.ResourceWithIdentity = &TagResource{}
 
 type TagResource struct {
 	client *peekaping.Client
@@ -181,6 +182,10 @@ func (r *TagResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 	}
 }
 
+func (r *TagResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *TagResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -223,6 +228,7 @@ func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, re
 	}
 	setModelFromTag(&plan, t)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, plan.ID.ValueString())...)
 }
 
 func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -249,6 +255,7 @@ func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	// Use state-aware field mapping to handle API inconsistencies
 	setModelFromTagWithState(&state, t, &state)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, state.ID.ValueString())...)
 }
 
 func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -287,6 +294,7 @@ func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	}
 	setModelFromTag(&plan, t)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, plan.ID.ValueString())...)
 }
 
 func (r *TagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -303,9 +311,28 @@ func (r *TagResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 }
 
 func (r *TagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID, diags := importIDFromRequest(ctx, req, r.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := importLookup(importID, "name", func() ([]peekaping.Tag, error) {
+		list, err := r.client.ListTags(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	}, func(x *peekaping.Tag) (string, string) { return x.Name, x.ID }, "tag")
+	if err != nil {
+		resp.Diagnostics.AddError("import failed", err.Error())
+		return
+	}
+
 	var state tagResourceModel
-	state.ID = types.StringValue(req.ID)
+	state.ID = types.StringValue(id)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, id)...)
 }
 
 func setModelFromTag(m *tagResourceModel, from *peekaping.Tag) {
@@ -0,0 +1,133 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// statusPageFooterTextMaxLength is the longest footer text accepted, in characters.
+const statusPageFooterTextMaxLength = 200
+
+// statusPageContentKey is the private state key holding the last applied content, used
+// to summarize changes in plans without storing file contents in attributes.
+const statusPageContentKey = "content"
+
+// statusPageContent is the custom CSS and footer text sent to the server.
+type statusPageContent struct {
+	CustomCSS  string `json:"custom_css"`
+	FooterText string `json:"footer_text"`
+}
+
+// hash returns the SHA-256 of the content, recorded as content_hash.
+func (c statusPageContent) hash() string {
+	sum := sha256.Sum256([]byte("custom_css\x00" + c.CustomCSS + "\x00footer_text\x00" + c.FooterText))
+	return hex.EncodeToString(sum[:])
+}
+
+// readContentFile reads a UTF-8 text file.
+func readContentFile(name string) (string, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return "", err
+	}
+	if !utf8.Valid(data) {
+		return "", fmt.Errorf("%s is not UTF-8 text", name)
+	}
+	return string(data), nil
+}
+
+// resolveContent returns the configured value of an inline attribute or its _file variant.
+// The result is unknown if either is unknown.
+func resolveContent(inline, file types.String) (types.String, error) {
+	if file.IsUnknown() {
+		return types.StringUnknown(), nil
+	}
+	if file.IsNull() {
+		return inline, nil
+	}
+	s, err := readContentFile(file.ValueString())
+	if err != nil {
+		return types.StringNull(), err
+	}
+	return types.StringValue(s), nil
+}
+
+// checkFooterText rejects footer text above the length limit.
+func checkFooterText(text string) error {
+	if n := utf8.RuneCountInString(text); n > statusPageFooterTextMaxLength {
+		return fmt.Errorf("footer text is %d characters, the limit is %d", n, statusPageFooterTextMaxLength)
+	}
+	return nil
+}
+
+// summarizeContentChange describes how new differs from old by lines added and removed,
+// e.g. "custom_css: 12 lines added, 3 removed (6.1 KiB -> 6.3 KiB)".
+func summarizeContentChange(name, old, new string) string {
+	counts := make(map[string]int)
+	for _, l := range strings.Split(old, "\n") {
+		counts[l]++
+	}
+	added := 0
+	for _, l := range strings.Split(new, "\n") {
+		if counts[l] > 0 {
+			counts[l]--
+		} else {
+			added++
+		}
+	}
+	removed := 0
+	for _, n := range counts {
+		removed += n
+	}
+	return fmt.Sprintf("%s: %d lines added, %d removed (%s -> %s)", name, added, removed, formatBytes(len(old)), formatBytes(len(new)))
+}
+
+func formatBytes(n int) string {
+	if n < 1024 {
+		return fmt.Sprintf("%d B", n)
+	}
+	return fmt.Sprintf("%.1f KiB", float64(n)/1024)
+}
+
+// privateState is implemented by the private state of resource requests and responses.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// privateStateSetter is implemented by the private state of resource responses.
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// getPrivateContent returns the last applied content, if recorded.
+func getPrivateContent(ctx context.Context, p privateState) (statusPageContent, bool) {
+	var c statusPageContent
+	b, diags := p.GetKey(ctx, statusPageContentKey)
+	if diags.HasError() || len(b) == 0 || json.Unmarshal(b, &c) != nil {
+		return c, false
+	}
+	return c, true
+}
+
+// setPrivateContent records the applied content.
+func setPrivateContent(ctx context.Context, p privateStateSetter, c statusPageContent) diag.Diagnostics {
+	b, err := json.Marshal(c)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("encode status page content failed", err.Error())
+		return diags
+	}
+	return p.SetKey(ctx, statusPageContentKey, b)
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSummarizeContentChange(t *testing.T) {
+	old := "body {\n  color: red;\n}\n"
+	new := "body {\n  color: blue;\n  margin: 0;\n}\n"
+	want := "custom_css: 2 lines added, 1 removed (23 B -> 37 B)"
+	if got := summarizeContentChange("custom_css", old, new); got != want {
+		t.Errorf("summarizeContentChange() = %q, want %q", got, want)
+	}
+}
+
+func TestResolveContent(t *testing.T) {
+	dir := t.TempDir()
+	css := filepath.Join(dir, "status.css")
+	if err := os.WriteFile(css, []byte("body {}\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	binary := filepath.Join(dir, "logo.png")
+	if err := os.WriteFile(binary, []byte{0xff, 0xfe, 0x00}, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := resolveContent(types.StringNull(), types.StringValue(css))
+	if err != nil || got.ValueString() != "body {}\n" {
+		t.Errorf("resolveContent(file) = %s, %v", got, err)
+	}
+	got, err = resolveContent(types.StringValue("inline"), types.StringNull())
+	if err != nil || got.ValueString() != "inline" {
+		t.Errorf("resolveContent(inline) = %s, %v", got, err)
+	}
+	if got, _ := resolveContent(types.StringNull(), types.StringUnknown()); !got.IsUnknown() {
+		t.Errorf("resolveContent(unknown file) = %s, want unknown", got)
+	}
+	if _, err := resolveContent(types.StringNull(), types.StringValue(binary)); err == nil {
+		t.Error("resolveContent() of a binary file should fail")
+	}
+}
+
+func TestCheckFooterText(t *testing.T) {
+	if err := checkFooterText(strings.Repeat("ü", statusPageFooterTextMaxLength)); err != nil {
+		t.Errorf("checkFooterText() of %d multi-byte characters error = %v", statusPageFooterTextMaxLength, err)
+	}
+	if err := checkFooterText(strings.Repeat("a", statusPageFooterTextMaxLength+1)); err == nil || !strings.Contains(err.Error(), "201 characters") {
+		t.Errorf("checkFooterText() over the limit error = %v", err)
+	}
+}
+
+// TestStatusPagePlanRemovedCSS tests that removing custom_css from the configuration
+// plans it as cleared instead of keeping the CSS from state.
+func TestStatusPagePlanRemovedCSS(t *testing.T) {
+	ctx := context.Background()
+	r := &StatusPageResource{}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	object := func(set map[string]tftypes.Value) tftypes.Value {
+		vals := map[string]tftypes.Value{}
+		for name, typ := range objType.AttributeTypes {
+			vals[name] = tftypes.NewValue(typ, nil)
+		}
+		for name, v := range set {
+			vals[name] = v
+		}
+		return tftypes.NewValue(objType, vals)
+	}
+	str := func(s string) tftypes.Value { return tftypes.NewValue(tftypes.String, s) }
+
+	old := statusPageContent{CustomCSS: "body {}"}
+	state := object(map[string]tftypes.Value{
+		"id":           str("sp"),
+		"title":        str("Status"),
+		"slug":         str("status"),
+		"custom_css":   str(old.CustomCSS),
+		"content_hash": str(old.hash()),
+	})
+	// UseStateForUnknown carries the old CSS into the proposed plan.
+	plan := object(map[string]tftypes.Value{
+		"id":           str("sp"),
+		"title":        str("Status"),
+		"slug":         str("status"),
+		"custom_css":   str(old.CustomCSS),
+		"content_hash": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})
+	config := object(map[string]tftypes.Value{"title": str("Status"), "slug": str("status")})
+
+	resp := resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: plan}}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config},
+		Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: plan},
+		State:  tfsdk.State{Schema: schemaResp.Schema, Raw: state},
+	}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("ModifyPlan: %v", resp.Diagnostics)
+	}
+	var css, hash types.String
+	resp.Plan.GetAttribute(ctx, path.Root("custom_css"), &css)
+	resp.Plan.GetAttribute(ctx, path.Root("content_hash"), &hash)
+	if css.IsUnknown() || css.ValueString() != "" {
+		t.Errorf("planned custom_css = %s, want empty", css)
+	}
+	if hash.ValueString() != (statusPageContent{}).hash() {
+		t.Errorf("planned content_hash = %s, want the hash of empty content", hash)
+	}
+}
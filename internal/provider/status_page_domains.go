@@ -0,0 +1,176 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/net/idna"
+
+	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
+)
+
+// normalizeStatusPageDomain checks that domain is a bare hostname and returns its
+// lowercase ASCII (punycode) form, used to compare domains.
+func normalizeStatusPageDomain(domain string) (string, error) {
+	if strings.Contains(domain, "://") {
+		if u, err := url.Parse(domain); err == nil && u.Hostname() != "" {
+			return "", fmt.Errorf("%q must be a hostname without scheme or path, did you mean %q?", domain, u.Hostname())
+		}
+		return "", fmt.Errorf("%q must be a hostname without scheme or path", domain)
+	}
+	if strings.ContainsAny(domain, "/:@?# \t") {
+		return "", fmt.Errorf("%q must be a hostname without port, path or whitespace", domain)
+	}
+	if net.ParseIP(domain) != nil {
+		return "", fmt.Errorf("%q is an IP address, expected a hostname", domain)
+	}
+	ascii, err := idna.Lookup.ToASCII(domain)
+	if err != nil {
+		return "", fmt.Errorf("%q is not a valid hostname: %w", domain, err)
+	}
+	if !strings.Contains(ascii, ".") || strings.HasSuffix(ascii, ".") {
+		return "", fmt.Errorf("%q must be a fully qualified hostname such as status.example.com", domain)
+	}
+	return ascii, nil
+}
+
+// statusPageDomainsValidator validates each domain and rejects duplicates.
+type statusPageDomainsValidator struct{}
+
+func (v statusPageDomainsValidator) Description(_ context.Context) string {
+	return "Domains must be unique hostnames without scheme, port or path"
+}
+
+func (v statusPageDomainsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v statusPageDomainsValidator) ValidateList(_ context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	seen := make(map[string]string)
+	for i, e := range req.ConfigValue.Elements() {
+		s, ok := e.(types.String)
+		if !ok || s.IsNull() || s.IsUnknown() {
+			continue
+		}
+		domain, err := normalizeStatusPageDomain(s.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(req.Path.AtListIndex(i), "Invalid Domain", err.Error())
+			continue
+		}
+		if prev, dup := seen[domain]; dup {
+			resp.Diagnostics.AddAttributeError(req.Path.AtListIndex(i), "Duplicate Domain", fmt.Sprintf("%q is the same domain as %q.", s.ValueString(), prev))
+			continue
+		}
+		seen[domain] = s.ValueString()
+	}
+}
+
+// statusPageDomainConflicts returns an error for each of domains already used by a status
+// page other than selfID.
+func statusPageDomainConflicts(pages []peekaping.StatusPage, selfID string, domains []string) []string {
+	owner := make(map[string]*peekaping.StatusPage)
+	for i := range pages {
+		if pages[i].ID == selfID {
+			continue
+		}
+		for _, d := range pages[i].Domains {
+			if ascii, err := normalizeStatusPageDomain(d); err == nil {
+				owner[ascii] = &pages[i]
+			}
+		}
+	}
+	var conflicts []string
+	for _, d := range domains {
+		ascii, err := normalizeStatusPageDomain(d)
+		if err != nil {
+			continue
+		}
+		if sp, ok := owner[ascii]; ok {
+			conflicts = append(conflicts, fmt.Sprintf("%q is already used by status page %q (%s)", d, sp.Title, sp.ID))
+		}
+	}
+	return conflicts
+}
+
+var statusPageDNSRecordAttrTypes = map[string]attr.Type{
+	"type":  types.StringType,
+	"name":  types.StringType,
+	"value": types.StringType,
+}
+
+// statusPageDNSTargetValidator validates dns_target as an IP address or hostname.
+type statusPageDNSTargetValidator struct{}
+
+func (v statusPageDNSTargetValidator) Description(_ context.Context) string {
+	return "DNS target must be an IP address or a hostname without scheme, port or path"
+}
+
+func (v statusPageDNSTargetValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v statusPageDNSTargetValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || net.ParseIP(req.ConfigValue.ValueString()) != nil {
+		return
+	}
+	if _, err := normalizeStatusPageDomain(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid DNS Target", err.Error())
+	}
+}
+
+// statusPageDNSTarget returns dns_target, or the host of the provider endpoint if it is
+// not set.
+func statusPageDNSTarget(target types.String, endpoint string) string {
+	if !target.IsNull() && !target.IsUnknown() {
+		return target.ValueString()
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return ""
+	}
+	return u.Hostname()
+}
+
+// statusPageDNSRecords returns the records pointing domains at target: a CNAME to target,
+// or an A/AAAA record if target is an IP address.
+func statusPageDNSRecords(domains []string, target string) types.List {
+	elemType := types.ObjectType{AttrTypes: statusPageDNSRecordAttrTypes}
+	if target == "" {
+		return types.ListNull(elemType)
+	}
+	recordType, value := "CNAME", target
+	if ip := net.ParseIP(target); ip != nil {
+		recordType, value = "A", ip.String()
+		if ip.To4() == nil {
+			recordType = "AAAA"
+		}
+	} else if ascii, err := idna.Lookup.ToASCII(target); err == nil {
+		value = ascii
+	}
+
+	elems := make([]attr.Value, 0, len(domains))
+	for _, d := range domains {
+		name, err := normalizeStatusPageDomain(d)
+		if err != nil {
+			name = d
+		}
+		elems = append(elems, types.ObjectValueMust(statusPageDNSRecordAttrTypes, map[string]attr.Value{
+			"type":  types.StringValue(recordType),
+			"name":  types.StringValue(name),
+			"value": types.StringValue(value),
+		}))
+	}
+	return types.ListValueMust(elemType, elems)
+}
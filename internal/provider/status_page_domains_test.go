@@ -0,0 +1,84 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
+)
+
+func TestNormalizeStatusPageDomain(t *testing.T) {
+	tests := []struct {
+		domain  string
+		want    string
+		wantErr string
+	}{
+		{"status.example.com", "status.example.com", ""},
+		{"Status.Example.COM", "status.example.com", ""},
+		{"bücher.example", "xn--bcher-kva.example", ""},
+		{"https://status.example.com/", "", `did you mean "status.example.com"`},
+		{"status.example.com:8080", "", "without port"},
+		{"status.example.com/path", "", "without port, path"},
+		{"192.0.2.1", "", "IP address"},
+		{"localhost", "", "fully qualified"},
+		{"status.example.com.", "", "fully qualified"},
+		{"-bad-.example.com", "", "not a valid hostname"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.domain, func(t *testing.T) {
+			got, err := normalizeStatusPageDomain(tt.domain)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("normalizeStatusPageDomain() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Fatalf("normalizeStatusPageDomain() = %q, %v, want %q", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestStatusPageDomainConflicts(t *testing.T) {
+	pages := []peekaping.StatusPage{
+		{ID: "a", Title: "A", Domains: []string{"status.example.com"}},
+		{ID: "b", Title: "B", Domains: []string{"xn--bcher-kva.example"}},
+	}
+	got := statusPageDomainConflicts(pages, "a", []string{"Status.Example.com", "bücher.example", "new.example.com"})
+	if len(got) != 1 || !strings.Contains(got[0], `status page "B"`) {
+		t.Errorf("statusPageDomainConflicts() = %q, want one conflict with B", got)
+	}
+}
+
+func TestStatusPageDNSRecords(t *testing.T) {
+	tests := []struct {
+		endpoint  string
+		target    types.String
+		wantType  string
+		wantValue string
+	}{
+		{"https://peekaping.example.com:8383", types.StringNull(), "CNAME", "peekaping.example.com"},
+		{"http://192.0.2.10", types.StringNull(), "A", "192.0.2.10"},
+		{"http://[2001:db8::1]:8080", types.StringNull(), "AAAA", "2001:db8::1"},
+		{"http://localhost:8034", types.StringValue("status.example.net"), "CNAME", "status.example.net"},
+		{"http://localhost:8034", types.StringValue("198.51.100.7"), "A", "198.51.100.7"},
+	}
+	for _, tt := range tests {
+		records := statusPageDNSRecords([]string{"bücher.example"}, statusPageDNSTarget(tt.target, tt.endpoint))
+		if len(records.Elements()) != 1 {
+			t.Fatalf("%s: got %d records", tt.endpoint, len(records.Elements()))
+		}
+		attrs := records.Elements()[0].(types.Object).Attributes()
+		if attrs["type"].(types.String).ValueString() != tt.wantType ||
+			attrs["name"].(types.String).ValueString() != "xn--bcher-kva.example" ||
+			attrs["value"].(types.String).ValueString() != tt.wantValue {
+			t.Errorf("%s: got %v", tt.endpoint, attrs)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// statusPageIconMaxBytes is the largest icon accepted from icon_file or icon_base64.
+const statusPageIconMaxBytes = 1 << 20
+
+// statusPageIconTypes are the image formats accepted as status page icons.
+var statusPageIconTypes = []string{"image/png", "image/jpeg", "image/gif", "image/webp", "image/x-icon", "image/svg+xml"}
+
+// statusPageIcon is a decoded icon image.
+type statusPageIcon struct {
+	ContentType string
+	Data        []byte
+}
+
+// dataURL encodes the icon the way the server stores uploaded icons.
+func (i *statusPageIcon) dataURL() string {
+	return "data:" + i.ContentType + ";base64," + base64.StdEncoding.EncodeToString(i.Data)
+}
+
+// hash returns the SHA-256 of the image bytes, recorded as icon_hash.
+func (i *statusPageIcon) hash() string {
+	sum := sha256.Sum256(i.Data)
+	return hex.EncodeToString(sum[:])
+}
+
+// newStatusPageIcon checks the size and format of an image and detects its content type.
+func newStatusPageIcon(data []byte) (*statusPageIcon, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("icon is empty")
+	}
+	if len(data) > statusPageIconMaxBytes {
+		return nil, fmt.Errorf("icon is %d bytes, the limit is %d", len(data), statusPageIconMaxBytes)
+	}
+	contentType := detectIconType(data)
+	if !slices.Contains(statusPageIconTypes, contentType) {
+		return nil, fmt.Errorf("icon has content type %s, expected one of %s", contentType, strings.Join(statusPageIconTypes, ", "))
+	}
+	return &statusPageIcon{ContentType: contentType, Data: data}, nil
+}
+
+// detectIconType sniffs the content type, recognizing SVG, which http.DetectContentType
+// reports as text.
+func detectIconType(data []byte) string {
+	contentType, _, _ := strings.Cut(http.DetectContentType(data), ";")
+	if strings.HasPrefix(contentType, "text/") {
+		head := bytes.ToLower(data[:min(len(data), 1024)])
+		if bytes.Contains(head, []byte("<svg")) {
+			return "image/svg+xml"
+		}
+	}
+	return contentType
+}
+
+// decodeIconBase64 decodes icon_base64, which may be plain base64 or a data URL.
+func decodeIconBase64(s string) (*statusPageIcon, error) {
+	data, err := decodeBase64Image(s)
+	if err != nil {
+		return nil, fmt.Errorf("icon_base64 %w", err)
+	}
+	return newStatusPageIcon(data)
+}
+
+// decodeBase64Image decodes plain base64 or the payload of a base64 data URL.
+func decodeBase64Image(s string) ([]byte, error) {
+	if rest, ok := strings.CutPrefix(strings.TrimSpace(s), "data:"); ok {
+		_, payload, found := strings.Cut(rest, ";base64,")
+		if !found {
+			return nil, fmt.Errorf("is a data URL without base64 payload")
+		}
+		s = payload
+	}
+	data, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(s), ""))
+	if err != nil {
+		return nil, fmt.Errorf("is not valid base64: %w", err)
+	}
+	return data, nil
+}
+
+// readIconFile reads and checks the image at icon_file.
+func readIconFile(name string) (*statusPageIcon, error) {
+	info, err := os.Stat(name)
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() > statusPageIconMaxBytes {
+		return nil, fmt.Errorf("%s is %d bytes, the limit is %d", name, info.Size(), statusPageIconMaxBytes)
+	}
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	icon, err := newStatusPageIcon(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return icon, nil
+}
+
+// loadStatusPageIcon returns the icon configured by icon_file or icon_base64, or nil if
+// neither is set.
+func loadStatusPageIcon(file, b64 types.String) (*statusPageIcon, error) {
+	switch {
+	case !file.IsNull():
+		return readIconFile(file.ValueString())
+	case !b64.IsNull():
+		return decodeIconBase64(b64.ValueString())
+	}
+	return nil, nil
+}
+
+// iconHashFromDataURL hashes the image in an icon data URL. It returns null for icons
+// that aren't data URLs, such as links to hosted images.
+func iconHashFromDataURL(icon string) types.String {
+	if !strings.HasPrefix(icon, "data:") {
+		return types.StringNull()
+	}
+	data, err := decodeBase64Image(icon)
+	if err != nil {
+		return types.StringNull()
+	}
+	return types.StringValue((&statusPageIcon{Data: data}).hash())
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
+)
+
+func TestStatusPageIcon(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	svg := []byte(`<?xml version="1.0"?><svg xmlns="http://www.w3.org/2000/svg"></svg>`)
+
+	tests := []struct {
+		name     string
+		b64      string
+		wantType string
+		wantErr  bool
+	}{
+		{"png", base64.StdEncoding.EncodeToString(png), "image/png", false},
+		{"svg", base64.StdEncoding.EncodeToString(svg), "image/svg+xml", false},
+		{"data url", "data:image/png;base64," + base64.StdEncoding.EncodeToString(png), "image/png", false},
+		{"wrapped", base64.StdEncoding.EncodeToString(png)[:8] + "\n" + base64.StdEncoding.EncodeToString(png)[8:], "image/png", false},
+		{"text", base64.StdEncoding.EncodeToString([]byte("hello")), "", true},
+		{"too large", base64.StdEncoding.EncodeToString(append(png, make([]byte, statusPageIconMaxBytes)...)), "", true},
+		{"not base64", "%%%", "", true},
+		{"empty", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			icon, err := decodeIconBase64(tt.b64)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("decodeIconBase64() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && icon.ContentType != tt.wantType {
+				t.Errorf("ContentType = %q, want %q", icon.ContentType, tt.wantType)
+			}
+		})
+	}
+
+	name := filepath.Join(t.TempDir(), "icon.png")
+	if err := os.WriteFile(name, png, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	fromFile, err := loadStatusPageIcon(types.StringValue(name), types.StringNull())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(fromFile.Data, png) {
+		t.Errorf("loaded %q, want %q", fromFile.Data, png)
+	}
+	// The hash of the uploaded data URL must match the hash planned from the file.
+	if got := iconHashFromDataURL(fromFile.dataURL()); got.ValueString() != fromFile.hash() {
+		t.Errorf("iconHashFromDataURL() = %s, want %s", got, fromFile.hash())
+	}
+	if got := iconHashFromDataURL("https://example.com/icon.png"); !got.IsNull() {
+		t.Errorf("iconHashFromDataURL() of a URL = %s, want null", got)
+	}
+	if icon, err := loadStatusPageIcon(types.StringNull(), types.StringNull()); icon != nil || err != nil {
+		t.Errorf("loadStatusPageIcon() without source = %v, %v", icon, err)
+	}
+}
+
+// TestStatusPageValidateConfigIconErrors tests that icon errors don't hide the other
+// config checks.
+func TestStatusPageValidateConfigIconErrors(t *testing.T) {
+	ctx := context.Background()
+	r := &StatusPageResource{}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	config := func(set map[string]tftypes.Value) tfsdk.Config {
+		vals := map[string]tftypes.Value{}
+		for name, typ := range objType.AttributeTypes {
+			vals[name] = tftypes.NewValue(typ, nil)
+		}
+		for name, v := range set {
+			vals[name] = v
+		}
+		return tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objType, vals)}
+	}
+	str := func(s string) tftypes.Value { return tftypes.NewValue(tftypes.String, s) }
+	unknown := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+
+	tests := []struct {
+		name string
+		icon map[string]tftypes.Value
+		want []string
+	}{
+		{"conflicting icons", map[string]tftypes.Value{"icon": str("https://example.com/i.png"), "icon_base64": str("aGVsbG8=")},
+			[]string{"icon_base64", "custom_css_file", "password_wo"}},
+		{"unknown icon", map[string]tftypes.Value{"icon_file": unknown},
+			[]string{"custom_css_file", "password_wo"}},
+		{"invalid icon", map[string]tftypes.Value{"icon_base64": str("aGVsbG8=")},
+			[]string{"icon_base64", "custom_css_file", "password_wo"}},
+	}
+	for _, tt := range tests {
+		set := map[string]tftypes.Value{
+			"title":           str("Status"),
+			"slug":            str("status"),
+			"custom_css":      str("body {}"),
+			"custom_css_file": str("theme.css"),
+			"password":        str("secret"),
+			"password_wo":     str("secret"),
+		}
+		for name, v := range tt.icon {
+			set[name] = v
+		}
+		var resp resource.ValidateConfigResponse
+		r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config(set)}, &resp)
+		var got []string
+		for _, d := range resp.Diagnostics.Errors() {
+			got = append(got, d.(diag.DiagnosticWithPath).Path().String())
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: errors on %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+// TestStatusPageIconUpdate tests the icon sent on update, including clearing an uploaded
+// icon removed from the configuration so it isn't read back against a null plan.
+func TestStatusPageIconUpdate(t *testing.T) {
+	png := base64.StdEncoding.EncodeToString([]byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR"))
+	uploaded, err := loadStatusPageIcon(types.StringNull(), types.StringValue(png))
+	if err != nil {
+		t.Fatal(err)
+	}
+	withIcon := func(icon, b64, hash types.String) *statusPageResourceModel {
+		return &statusPageResourceModel{Icon: icon, IconFile: types.StringNull(), IconBase64: b64, IconHash: hash}
+	}
+	null := types.StringNull()
+	hash := types.StringValue(uploaded.hash())
+	url := types.StringValue("https://example.com/icon.png")
+
+	tests := []struct {
+		name        string
+		plan, state *statusPageResourceModel
+		want        *string
+	}{
+		{"unchanged upload", withIcon(null, types.StringValue(png), hash), withIcon(null, types.StringValue(png), hash), nil},
+		{"new upload", withIcon(null, types.StringValue(png), hash), withIcon(null, null, null), ptr(uploaded.dataURL())},
+		{"url", withIcon(url, null, null), withIcon(null, null, null), ptr(url.ValueString())},
+		{"remove upload", withIcon(null, null, null), withIcon(null, types.StringValue(png), hash), ptr("")},
+		{"remove url", withIcon(null, null, null), withIcon(url, null, null), ptr("")},
+		{"never set", withIcon(null, null, null), withIcon(null, null, null), nil},
+	}
+	for _, tt := range tests {
+		got, err := statusPageIconUpdate(tt.plan, tt.state)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+			t.Errorf("%s: sent icon %v, want %v", tt.name, deref(got), deref(tt.want))
+		}
+	}
+
+	// The cleared icon reads back as null, matching the plan.
+	m := withIcon(null, null, null)
+	setModelFromStatusPageWithState(m, &peekaping.StatusPage{ID: "sp", Title: "Status"}, m)
+	if !m.Icon.IsNull() || !m.IconHash.IsNull() {
+		t.Errorf("cleared icon read back as icon %s, icon_hash %s", m.Icon, m.IconHash)
+	}
+}
+
+func ptr(s string) *string { return &s }
+
+func deref(s *string) any {
+	if s == nil {
+		return nil
+	}
+	return *s
+}
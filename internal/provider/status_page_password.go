@@ -0,0 +1,72 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/crypto/argon2"
+)
+
+// Argon2id parameters for password_hash, the OWASP minimum for interactive logins. The
+// hash is compared on every plan, so it has to stay fast enough for that.
+const (
+	statusPagePasswordTime    = 2
+	statusPagePasswordMemory  = 19 * 1024
+	statusPagePasswordThreads = 1
+	statusPagePasswordKeyLen  = 32
+)
+
+// statusPagePasswordParams is the PHC string prefix of hashes made with the parameters
+// above.
+var statusPagePasswordParams = fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$",
+	argon2.Version, statusPagePasswordMemory, statusPagePasswordTime, statusPagePasswordThreads)
+
+// hashStatusPagePassword returns the Argon2id hash of password in PHC string format,
+// recorded as password_hash so changes can be detected without storing the password.
+func hashStatusPagePassword(password string, salt []byte) string {
+	key := argon2.IDKey([]byte(password), salt, statusPagePasswordTime, statusPagePasswordMemory, statusPagePasswordThreads, statusPagePasswordKeyLen)
+	return statusPagePasswordParams + base64.RawStdEncoding.EncodeToString(salt) + "$" + base64.RawStdEncoding.EncodeToString(key)
+}
+
+// newStatusPagePasswordHash hashes password with a random salt.
+func newStatusPagePasswordHash(password string) string {
+	salt := make([]byte, 16)
+	_, _ = rand.Read(salt)
+	return hashStatusPagePassword(password, salt)
+}
+
+// statusPagePasswordMatches reports whether password_hash was computed from password.
+// Hashes made with other parameters never match, so the password is sent and rehashed.
+func statusPagePasswordMatches(hash types.String, password string) bool {
+	if hash.IsNull() || hash.IsUnknown() {
+		return false
+	}
+	rest, ok := strings.CutPrefix(hash.ValueString(), statusPagePasswordParams)
+	if !ok {
+		return false
+	}
+	encodedSalt, _, ok := strings.Cut(rest, "$")
+	if !ok {
+		return false
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(encodedSalt)
+	if err != nil {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(hashStatusPagePassword(password, salt)), []byte(hash.ValueString())) == 1
+}
+
+// configuredPassword returns password or password_wo, whichever is set.
+func configuredPassword(password, passwordWO types.String) types.String {
+	if !password.IsNull() {
+		return password
+	}
+	return passwordWO
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2025 tafaust
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/tafaust/terraform-provider-peekaping/internal/peekaping"
+)
+
+func TestStatusPagePasswordHash(t *testing.T) {
+	a := newStatusPagePasswordHash("secret")
+	b := newStatusPagePasswordHash("secret")
+	if a == b {
+		t.Error("hashes of the same password should use different salts")
+	}
+	if strings.Contains(a, "secret") {
+		t.Errorf("hash %q contains the password", a)
+	}
+	for _, hash := range []string{a, b} {
+		if !statusPagePasswordMatches(types.StringValue(hash), "secret") {
+			t.Errorf("statusPagePasswordMatches(%q, secret) = false", hash)
+		}
+		if statusPagePasswordMatches(types.StringValue(hash), "Secret") {
+			t.Errorf("statusPagePasswordMatches(%q, Secret) = true", hash)
+		}
+	}
+	for _, hash := range []types.String{types.StringNull(), types.StringUnknown(), types.StringValue("no-salt"), types.StringValue(statusPagePasswordParams + "!!$x")} {
+		if statusPagePasswordMatches(hash, "secret") {
+			t.Errorf("statusPagePasswordMatches(%s) = true", hash)
+		}
+	}
+}
+
+// TestStatusPagePasswordProtected tests reading password_protected when the server
+// reports or omits the password.
+func TestStatusPagePasswordProtected(t *testing.T) {
+	hash := types.StringValue(newStatusPagePasswordHash("secret"))
+	tests := []struct {
+		name     string
+		password string
+		state    *statusPageResourceModel
+		want     bool
+	}{
+		{"reported", "$argon2id$...", nil, true},
+		{"omitted without state", "", nil, false},
+		{"omitted after applying a password", "", &statusPageResourceModel{PasswordHash: hash, PasswordProtected: types.BoolUnknown()}, true},
+		{"omitted after removing the password", "", &statusPageResourceModel{PasswordHash: types.StringNull(), PasswordProtected: types.BoolUnknown()}, false},
+		{"omitted after a refresh", "", &statusPageResourceModel{PasswordHash: types.StringNull(), PasswordProtected: types.BoolValue(true)}, true},
+		{"omitted without a password", "", &statusPageResourceModel{PasswordHash: types.StringNull(), PasswordProtected: types.BoolValue(false)}, false},
+	}
+	for _, tt := range tests {
+		got := statusPagePasswordProtected(&peekaping.StatusPage{Password: tt.password}, tt.state)
+		if got != types.BoolValue(tt.want) {
+			t.Errorf("%s: password_protected = %s, want %t", tt.name, got, tt.want)
+		}
+	}
+}
+
+// TestStatusPagePasswordPlanStable tests that an unchanged password plans no change after
+// a refresh where the server omits the password.
+func TestStatusPagePasswordPlanStable(t *testing.T) {
+	ctx := context.Background()
+	r := &StatusPageResource{}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	object := func(set map[string]tftypes.Value) tftypes.Value {
+		vals := map[string]tftypes.Value{}
+		for name, typ := range objType.AttributeTypes {
+			vals[name] = tftypes.NewValue(typ, nil)
+		}
+		for name, v := range set {
+			vals[name] = v
+		}
+		return tftypes.NewValue(objType, vals)
+	}
+
+	// State after create and two refreshes, all from responses without the password
+	hash := newStatusPagePasswordHash("secret")
+	state := statusPageResourceModel{PasswordHash: types.StringValue(hash), PasswordProtected: types.BoolUnknown()}
+	for range 3 {
+		state.PasswordProtected = statusPagePasswordProtected(&peekaping.StatusPage{}, &state)
+	}
+	if !state.PasswordProtected.ValueBool() {
+		t.Fatalf("password_protected = %s after refresh, want true", state.PasswordProtected)
+	}
+
+	config := object(map[string]tftypes.Value{"password": tftypes.NewValue(tftypes.String, "secret")})
+	plan := object(map[string]tftypes.Value{
+		"password":           tftypes.NewValue(tftypes.String, "secret"),
+		"password_hash":      tftypes.NewValue(tftypes.String, hash),
+		"password_protected": tftypes.NewValue(tftypes.Bool, true),
+	})
+	resp := resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: plan}}
+	r.planPassword(ctx, resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config},
+		Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: plan},
+	}, &state, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+	if !resp.Plan.Raw.Equal(plan) {
+		t.Errorf("unchanged password planned a change: %s", resp.Plan.Raw)
+	}
+
+	// The plan keeps the password unsent on apply
+	planned := statusPageResourceModel{Password: types.StringValue("secret"), PasswordHash: types.StringValue(hash)}
+	password, diags := applyPassword(ctx, tfsdk.Config{Schema: schemaResp.Schema, Raw: config}, &planned, &state)
+	if diags.HasError() || password != nil {
+		t.Errorf("applyPassword() = %v, %v, want nothing sent", password, diags)
+	}
+}